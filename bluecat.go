@@ -6,18 +6,38 @@
 package bluecat
 
 import (
-	"crypto/tls"
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"gopkg.in/resty.v1"
 )
 
 // Bluecat contains all of the server information that is used when calling the package functions.
+//
+// The credentials of the session are kept for its lifetime. When the server rejects the
+// authentication token, for example because it has expired, the session logs in again and replays the request once.
 type Bluecat struct {
+	Scheme    string
 	Server    string
 	URI       string
 	AuthToken string
+	client    *resty.Client
+
+	credentials  Credentials
+	loginOptions string
+	loggedOut    bool
+	mu           sync.Mutex
+
+	retry   *RetryPolicy
+	limiter *rateLimiter
+	hooks   []Hook
 }
 
 // APIAccessRight class controls access right objects.
@@ -92,19 +112,38 @@ type ResponsePolicySearchResult struct {
 
 // getAuthToken returns the Bluecat session authentication token which is used to authenticate
 // all of the API calls to the BLuecat server.
-func getAuthToken(server, user, pass string) (string, error) {
+//
+// The Address Manager REST API only accepts the credentials as query parameters, so they are URL encoded, and the
+// password is redacted from the RequestInfo passed to hooks and from any returned error, see redactError.
+func (b *Bluecat) getAuthToken(ctx context.Context) (string, error) {
 	sessionToken := regexp.MustCompile(`^.*(BAMAuthToken:\s+[\w=]+)\s+.*$`)
-	connErr := regexp.MustCompile(`Get https.*:\s+(.*)`)
 
-	loginReq := fmt.Sprintf("https://%s/Services/REST/v1/login?username=%s&password=%s", server, user, pass)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		Get(loginReq)
+	user, pass, err := b.credentials(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w - getAuthToken credentials", err)
+	}
+
+	params := url.Values{}
+	params.Set("username", user)
+	params.Set("password", pass)
+
+	endpoint := "login"
+	if b.loginOptions != "" {
+		endpoint = "loginWithOptions"
+		params.Set("options", b.loginOptions)
+	}
+
+	resp, err := b.attempt(ctx, http.MethodGet, endpoint, params, nil, "", 1)
 
 	if err != nil {
-		formatted := connErr.ReplaceAllString(fmt.Sprintf("%s", err), "${1}")
-		return "", fmt.Errorf("%s - getAuthToken login", formatted)
-		//return "", fmt.Errorf("%s - getAuthToken login", err)
+		if uerr, ok := err.(*url.Error); ok {
+			err = uerr.Err
+		}
+		return "", fmt.Errorf("%w - getAuthToken login", err)
+	}
+
+	if !isSuccess(resp) {
+		return "", fmt.Errorf("%w - getAuthToken login", newAPIError(endpoint, resp))
 	}
 
 	token := sessionToken.FindStringSubmatch(resp.String())
@@ -115,22 +154,353 @@ func getAuthToken(server, user, pass string) (string, error) {
 	return token[1], nil
 }
 
-func init() {
-	resty.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+// NewSession initializes a session against the specificed Bluecat server.
+//
+// Parameter `server` is either a host name, optionally with a port, such as "bam.company.com:8443", or a full base
+// URL such as "http://localhost:8080" or "https://proxy.company.com/bam/Services/REST/v1". A host name is reached over
+// HTTPS, and the API path defaults to /Services/REST/v1 when the URL does not include one.
+//
+// The session logs in with `user` and `pass` unless the WithCredentials option supplies them instead.
+//
+// By default the server certificate is verified against the system root CAs. Use the Option functions, such as
+// WithCABundle, WithClientCertificates, WithProxy, WithTimeout or WithHTTPClient, to change how the session connects
+// to the server. Certificate verification can only be turned off explicitly with WithInsecureSkipVerify.
+func NewSession(server, user, pass string, opts ...Option) (*Bluecat, error) {
+	return NewSessionContext(context.Background(), server, user, pass, opts...)
 }
 
-// NewSession initializes a session against the specificed Bluecat server.
-func NewSession(server, user, pass string) (*Bluecat, error) {
-	token, err := getAuthToken(server, user, pass)
+// NewSessionContext initializes a session against the specified Bluecat server using ctx to cancel or time out the login.
+func NewSessionContext(ctx context.Context, server, user, pass string, opts ...Option) (*Bluecat, error) {
+	o := &options{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, fmt.Errorf("%w - NewSession initialization", err)
+		}
+	}
+
+	client, err := newClient(o)
 	if err != nil {
-		return nil, fmt.Errorf("%s - NewSession initialization", err)
+		return nil, fmt.Errorf("%w - NewSession initialization", err)
+	}
+
+	scheme, host, uri, err := parseServer(server)
+	if err != nil {
+		return nil, fmt.Errorf("%w - NewSession initialization", err)
 	}
 
 	bc := &Bluecat{
-		Server:    server,
-		URI:       "/Services/REST/v1",
-		AuthToken: token,
+		Scheme:       scheme,
+		Server:       host,
+		URI:          uri,
+		client:       client,
+		credentials:  o.credentials,
+		loginOptions: o.loginOptions,
+		retry:        o.retry,
+		limiter:      o.limiter,
+		hooks:        o.hooks,
+	}
+
+	if bc.credentials == nil {
+		bc.credentials = StaticCredentials(user, pass)
+	}
+
+	if err := bc.LoginContext(ctx); err != nil {
+		return nil, fmt.Errorf("%w - NewSession initialization", err)
 	}
 
 	return bc, nil
 }
+
+// Login authenticates against the Bluecat server with the credentials of the session and stores the new
+// authentication token. It can be used to resume a session after Logout.
+//
+// The login and loginWithOptions methods of the Address Manager REST API v1 accept the username and password only
+// as query parameters of a GET request, so the password is part of the request URL and may show up in the access
+// logs of the server and of any proxy in between. Use HTTPS, and a dedicated API user with the least access needed.
+// The session itself keeps the password out of the RequestInfo and ResponseInfo passed to hooks, including
+// LoggingHook and TracingHook, and out of the errors it returns.
+func (b *Bluecat) Login() error {
+	return b.LoginContext(context.Background())
+}
+
+// LoginContext performs Login using ctx to cancel or time out the request.
+func (b *Bluecat) LoginContext(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	token, err := b.getAuthToken(ctx)
+	if err != nil {
+		return fmt.Errorf("%w - Login request", err)
+	}
+
+	b.AuthToken = token
+	b.loggedOut = false
+
+	return nil
+}
+
+// Logout logs the session out of the Bluecat server and invalidates its authentication token. Any call made on
+// the session after Logout returns an error until Login is called again.
+func (b *Bluecat) Logout() error {
+	return b.LogoutContext(context.Background())
+}
+
+// LogoutContext performs Logout using ctx to cancel or time out the request.
+func (b *Bluecat) LogoutContext(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.loggedOut {
+		return nil
+	}
+
+	resp, err := b.send(ctx, http.MethodGet, "logout", nil, nil, b.AuthToken)
+	if err != nil {
+		return fmt.Errorf("%w - Logout request", err)
+	}
+
+	if !isSuccess(resp) && resp.StatusCode() != http.StatusUnauthorized {
+		return newAPIError("logout", resp)
+	}
+
+	b.AuthToken = ""
+	b.loggedOut = true
+
+	return nil
+}
+
+// errLoggedOut is returned for any call made on a session after Logout.
+var errLoggedOut = fmt.Errorf("%w - session is logged out", ErrUnauthorized)
+
+// token returns the current authentication token of the session.
+func (b *Bluecat) token() (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.loggedOut {
+		return "", errLoggedOut
+	}
+
+	return b.AuthToken, nil
+}
+
+// reauthenticate logs in again after the server rejected the token `stale`. If another request has already
+// replaced that token in the meantime, the session is left as it is.
+func (b *Bluecat) reauthenticate(ctx context.Context, stale string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.loggedOut {
+		return errLoggedOut
+	}
+
+	if b.AuthToken != stale {
+		return nil
+	}
+
+	token, err := b.getAuthToken(ctx)
+	if err != nil {
+		return err
+	}
+	b.AuthToken = token
+
+	return nil
+}
+
+// defaultURI is the path of the Address Manager REST API.
+const defaultURI = "/Services/REST/v1"
+
+// parseServer splits the `server` argument of NewSession into the scheme, host and API path of the session.
+func parseServer(server string) (string, string, string, error) {
+	if !strings.Contains(server, "://") {
+		return "https", server, defaultURI, nil
+	}
+
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", "", "", fmt.Errorf("unsupported scheme %q in server URL", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return "", "", "", fmt.Errorf("missing host in server URL %q", server)
+	}
+
+	uri := strings.TrimRight(u.Path, "/")
+	if uri == "" {
+		uri = defaultURI
+	}
+
+	return u.Scheme, u.Host, uri, nil
+}
+
+// endpoint returns the URL of the API method `call` with the query string built from params. Every value in params
+// is URL encoded, so names, properties strings and search filters are sent to the server unchanged.
+func (b *Bluecat) endpoint(call string, params url.Values) string {
+	scheme := b.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	req := fmt.Sprintf("%s://%s%s/%s", scheme, b.Server, b.URI, call)
+	if len(params) > 0 {
+		req += "?" + params.Encode()
+	}
+
+	return req
+}
+
+// do sends a request with the given HTTP method to the API method `call`, authenticated with the session token.
+// The request is bound to ctx, so it is aborted as soon as ctx is canceled or its deadline is exceeded. If the
+// server answers with 401 Unauthorized, the session logs in again and the request is replayed once with the new token.
+//
+// If body is not nil, it is encoded as JSON and sent as the request body.
+//
+// A response that does not have a 2xx status code is returned as an *APIError.
+func (b *Bluecat) do(ctx context.Context, method, call string, params url.Values, body interface{}) (*resty.Response, error) {
+	resp, err := b.authorized(ctx, method, call, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSuccess(resp) {
+		return nil, newAPIError(call, resp)
+	}
+
+	return resp, nil
+}
+
+// authorized sends the request and handles re-authentication for do.
+func (b *Bluecat) authorized(ctx context.Context, method, call string, params url.Values, body interface{}) (*resty.Response, error) {
+	token, err := b.token()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := b.send(ctx, method, call, params, body, token)
+	if err != nil || resp.StatusCode() != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if err := b.reauthenticate(ctx, token); err != nil {
+		return nil, fmt.Errorf("%w - re-authentication", err)
+	}
+
+	token, err = b.token()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.send(ctx, method, call, params, body, token)
+}
+
+// send performs a request to the API method `call` authenticated with token. Each attempt waits for the rate limiter
+// of the session, and failed attempts are retried according to its retry policy.
+func (b *Bluecat) send(ctx context.Context, method, call string, params url.Values, body interface{}, token string) (*resty.Response, error) {
+	attempts := 1
+	if b.retry != nil && (b.retry.RetryNonIdempotent || idempotent(method)) {
+		attempts = b.retry.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		if b.limiter != nil {
+			if err := b.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := b.attempt(ctx, method, call, params, body, token, attempt)
+		if attempt >= attempts || ctx.Err() != nil || !b.retry.retryable(resp, err) {
+			return resp, err
+		}
+
+		if err := sleep(ctx, b.retry.backoff(attempt, resp)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt performs a single request to the API method `call` authenticated with token, which is left out when
+// empty. The hooks of the session observe the request and its outcome.
+func (b *Bluecat) attempt(ctx context.Context, method, call string, params url.Values, body interface{}, token string, n int) (*resty.Response, error) {
+	req := b.endpoint(call, params)
+	info := &RequestInfo{
+		Method:     call,
+		HTTPMethod: method,
+		URL:        redactURL(req),
+		Attempt:    n,
+	}
+
+	for _, h := range b.hooks {
+		ctx = h.BeforeRequest(ctx, info)
+	}
+
+	r := b.client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json")
+	if token != "" {
+		r.SetHeader("Authorization", token)
+	}
+	if body != nil {
+		r.SetBody(body)
+	}
+
+	start := time.Now()
+	resp, err := r.Execute(method, req)
+	err = redactError(err, params)
+
+	if len(b.hooks) > 0 {
+		out := &ResponseInfo{
+			Request:  info,
+			Duration: time.Since(start),
+			Err:      err,
+		}
+		if resp != nil {
+			out.StatusCode = resp.StatusCode()
+		}
+
+		for i := len(b.hooks) - 1; i >= 0; i-- {
+			b.hooks[i].AfterResponse(ctx, out)
+		}
+	}
+
+	return resp, err
+}
+
+// get sends a GET request to the API method `call`.
+func (b *Bluecat) get(ctx context.Context, call string, params url.Values) (*resty.Response, error) {
+	return b.do(ctx, http.MethodGet, call, params, nil)
+}
+
+// post sends a POST request to the API method `call`.
+func (b *Bluecat) post(ctx context.Context, call string, params url.Values) (*resty.Response, error) {
+	return b.do(ctx, http.MethodPost, call, params, nil)
+}
+
+// put sends a PUT request to the API method `call`.
+func (b *Bluecat) put(ctx context.Context, call string, params url.Values) (*resty.Response, error) {
+	return b.do(ctx, http.MethodPut, call, params, nil)
+}
+
+// putJSON sends a PUT request to the API method `call` with body encoded as JSON.
+func (b *Bluecat) putJSON(ctx context.Context, call string, params url.Values, body interface{}) (*resty.Response, error) {
+	return b.do(ctx, http.MethodPut, call, params, body)
+}
+
+// delete sends a DELETE request to the API method `call`.
+func (b *Bluecat) delete(ctx context.Context, call string, params url.Values) (*resty.Response, error) {
+	return b.do(ctx, http.MethodDelete, call, params, nil)
+}
+
+// parseID returns the object ID in the body of a response to one of the add methods.
+func parseID(resp *resty.Response) (int64, error) {
+	id, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(resp.String()), "\""), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid object ID %q in response", resp.String())
+	}
+
+	return id, nil
+}
@@ -0,0 +1,127 @@
+package bluecat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testServer is an Address Manager stand-in. It answers login with a new token every time and passes all other API
+// calls to its handler, counting the calls per API method.
+type testServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// newTestSession starts a testServer with the given handler and returns a session that is logged in to it. The
+// caller closes the server.
+func newTestSession(t *testing.T, handler http.HandlerFunc, opts ...Option) (*Bluecat, *testServer) {
+	t.Helper()
+
+	ts := &testServer{calls: map[string]int{}}
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+		ts.mu.Lock()
+		ts.calls[call]++
+		if call == "login" {
+			fmt.Fprintf(w, "Session Token-> BAMAuthToken: token%d= <- for User : admin", ts.calls[call])
+			ts.mu.Unlock()
+			return
+		}
+		ts.mu.Unlock()
+
+		handler(w, r)
+	}))
+
+	b, err := NewSession(ts.URL, "admin", "secret", opts...)
+	if err != nil {
+		ts.Close()
+		t.Fatalf("NewSession: %v", err)
+	}
+
+	return b, ts
+}
+
+// count returns the number of calls made to the API method `call`.
+func (ts *testServer) count(call string) int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return ts.calls[call]
+}
+
+func TestReauthentication(t *testing.T) {
+	tests := []struct {
+		name       string
+		valid      string
+		wantErr    error
+		wantLogins int
+		wantCalls  int
+	}{
+		{name: "valid token", valid: "BAMAuthToken: token1=", wantLogins: 1, wantCalls: 1},
+		{name: "expired token", valid: "BAMAuthToken: token2=", wantLogins: 2, wantCalls: 2},
+		{name: "rejected after login", valid: "none", wantErr: ErrUnauthorized, wantLogins: 2, wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != tt.valid {
+					w.WriteHeader(http.StatusUnauthorized)
+					fmt.Fprint(w, "Authentication token expired")
+					return
+				}
+
+				fmt.Fprint(w, `{"id":4,"name":"net","type":"IP4Network"}`)
+			})
+			defer ts.Close()
+
+			e, err := b.GetEntityByID(4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetEntityByID error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && e.ID != 4 {
+				t.Errorf("GetEntityByID = %+v, want ID 4", e)
+			}
+
+			if n := ts.count("login"); n != tt.wantLogins {
+				t.Errorf("logins = %d, want %d", n, tt.wantLogins)
+			}
+
+			if n := ts.count("getEntityById"); n != tt.wantCalls {
+				t.Errorf("getEntityById calls = %d, want %d", n, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestLoggedOutSession(t *testing.T) {
+	b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":4,"name":"net","type":"IP4Network"}`)
+	})
+	defer ts.Close()
+
+	if err := b.Logout(); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+
+	if _, err := b.GetEntityByID(4); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("GetEntityByID after Logout error = %v, want ErrUnauthorized", err)
+	}
+
+	if n := ts.count("getEntityById"); n != 0 {
+		t.Errorf("getEntityById calls after Logout = %d, want 0", n)
+	}
+
+	if n := ts.count("login"); n != 1 {
+		t.Errorf("logins = %d, want 1", n)
+	}
+}
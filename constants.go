@@ -0,0 +1,429 @@
+package bluecat
+
+import "fmt"
+
+// ObjectType is the type of an Address Manager object, as used by the `objecttype` parameters and the Type field
+// of APIEntity.
+type ObjectType string
+
+// Object types.
+const (
+	ObjectTypeEntity                   ObjectType = "Entity"
+	ObjectTypeConfiguration            ObjectType = "Configuration"
+	ObjectTypeView                     ObjectType = "View"
+	ObjectTypeZone                     ObjectType = "Zone"
+	ObjectTypeInternalRootZone         ObjectType = "InternalRootZone"
+	ObjectTypeZoneTemplate             ObjectType = "ZoneTemplate"
+	ObjectTypeEnumZone                 ObjectType = "EnumZone"
+	ObjectTypeEnumNumber               ObjectType = "EnumNumber"
+	ObjectTypeRPZone                   ObjectType = "RPZone"
+	ObjectTypeHostRecord               ObjectType = "HostRecord"
+	ObjectTypeAliasRecord              ObjectType = "AliasRecord"
+	ObjectTypeMXRecord                 ObjectType = "MXRecord"
+	ObjectTypeTXTRecord                ObjectType = "TXTRecord"
+	ObjectTypeSRVRecord                ObjectType = "SRVRecord"
+	ObjectTypeGenericRecord            ObjectType = "GenericRecord"
+	ObjectTypeHINFORecord              ObjectType = "HINFORecord"
+	ObjectTypeNAPTRRecord              ObjectType = "NAPTRRecord"
+	ObjectTypeRecordWithLink           ObjectType = "RecordWithLink"
+	ObjectTypeExternalHostRecord       ObjectType = "ExternalHostRecord"
+	ObjectTypeStartOfAuthority         ObjectType = "StartOfAuthority"
+	ObjectTypeIP4Block                 ObjectType = "IP4Block"
+	ObjectTypeIP4Network               ObjectType = "IP4Network"
+	ObjectTypeIP4NetworkTemplate       ObjectType = "IP4NetworkTemplate"
+	ObjectTypeIP4Address               ObjectType = "IP4Address"
+	ObjectTypeIP4IPGroup               ObjectType = "IP4IPGroup"
+	ObjectTypeIP6Block                 ObjectType = "IP6Block"
+	ObjectTypeIP6Network               ObjectType = "IP6Network"
+	ObjectTypeIP6Address               ObjectType = "IP6Address"
+	ObjectTypeDHCP4Range               ObjectType = "DHCP4Range"
+	ObjectTypeDHCP6Range               ObjectType = "DHCP6Range"
+	ObjectTypeMACPool                  ObjectType = "MACPool"
+	ObjectTypeDenyMACPool              ObjectType = "DenyMACPool"
+	ObjectTypeMACAddress               ObjectType = "MACAddress"
+	ObjectTypeTagGroup                 ObjectType = "TagGroup"
+	ObjectTypeTag                      ObjectType = "Tag"
+	ObjectTypeUser                     ObjectType = "User"
+	ObjectTypeUserGroup                ObjectType = "UserGroup"
+	ObjectTypeServer                   ObjectType = "Server"
+	ObjectTypeServerGroup              ObjectType = "ServerGroup"
+	ObjectTypeNetworkServerInterface   ObjectType = "NetworkServerInterface"
+	ObjectTypePublishedServerInterface ObjectType = "PublishedServerInterface"
+	ObjectTypeNetworkInterface         ObjectType = "NetworkInterface"
+	ObjectTypeVirtualInterface         ObjectType = "VirtualInterface"
+	ObjectTypeLDAP                     ObjectType = "LDAP"
+	ObjectTypeKerberos                 ObjectType = "Kerberos"
+	ObjectTypeKerberosRealm            ObjectType = "KerberosRealm"
+	ObjectTypeRadius                   ObjectType = "Radius"
+	ObjectTypeTFTPGroup                ObjectType = "TFTPGroup"
+	ObjectTypeTFTPFolder               ObjectType = "TFTPFolder"
+	ObjectTypeTFTPFile                 ObjectType = "TFTPFile"
+	ObjectTypeTFTPDeploymentRole       ObjectType = "TFTPDeploymentRole"
+	ObjectTypeDNSDeploymentRole        ObjectType = "DNSDeploymentRole"
+	ObjectTypeDHCPDeploymentRole       ObjectType = "DHCPDeploymentRole"
+	ObjectTypeDNSOption                ObjectType = "DNSOption"
+	ObjectTypeDNSRawOption             ObjectType = "DNSRawOption"
+	ObjectTypeDHCPV4ClientOption       ObjectType = "DHCPV4ClientOption"
+	ObjectTypeDHCPServiceOption        ObjectType = "DHCPServiceOption"
+	ObjectTypeDHCPRawOption            ObjectType = "DHCPRawOption"
+	ObjectTypeDHCPV6ClientOption       ObjectType = "DHCPV6ClientOption"
+	ObjectTypeDHCPV6ServiceOption      ObjectType = "DHCPV6ServiceOption"
+	ObjectTypeDHCPV6RawOption          ObjectType = "DHCPV6RawOption"
+	ObjectTypeVendorProfile            ObjectType = "VendorProfile"
+	ObjectTypeVendorOptionDef          ObjectType = "VendorOptionDef"
+	ObjectTypeVendorClientOption       ObjectType = "VendorClientOption"
+	ObjectTypeCustomOptionDef          ObjectType = "CustomOptionDef"
+	ObjectTypeDHCPMatchClass           ObjectType = "DHCPMatchClass"
+	ObjectTypeDHCPSubClass             ObjectType = "DHCPSubClass"
+	ObjectTypeDevice                   ObjectType = "Device"
+	ObjectTypeDeviceType               ObjectType = "DeviceType"
+	ObjectTypeDeviceSubtype            ObjectType = "DeviceSubtype"
+	ObjectTypeDeploymentScheduler      ObjectType = "DeploymentScheduler"
+	ObjectTypeIP4ReconciliationPolicy  ObjectType = "IP4ReconciliationPolicy"
+	ObjectTypeDNSSECSigningPolicy      ObjectType = "DNSSECSigningPolicy"
+	ObjectTypeTSIGKey                  ObjectType = "TSIGKey"
+	ObjectTypeACL                      ObjectType = "ACL"
+	ObjectTypeResponsePolicy           ObjectType = "ResponsePolicy"
+	ObjectTypeLocation                 ObjectType = "Location"
+	ObjectTypeInterfaceID              ObjectType = "InterfaceID"
+)
+
+// objectTypes is the set of known object types.
+var objectTypes = map[ObjectType]bool{
+	ObjectTypeEntity: true, ObjectTypeConfiguration: true, ObjectTypeView: true, ObjectTypeZone: true,
+	ObjectTypeInternalRootZone: true, ObjectTypeZoneTemplate: true, ObjectTypeEnumZone: true,
+	ObjectTypeEnumNumber: true, ObjectTypeRPZone: true, ObjectTypeHostRecord: true, ObjectTypeAliasRecord: true,
+	ObjectTypeMXRecord: true, ObjectTypeTXTRecord: true, ObjectTypeSRVRecord: true, ObjectTypeGenericRecord: true,
+	ObjectTypeHINFORecord: true, ObjectTypeNAPTRRecord: true, ObjectTypeRecordWithLink: true,
+	ObjectTypeExternalHostRecord: true, ObjectTypeStartOfAuthority: true, ObjectTypeIP4Block: true,
+	ObjectTypeIP4Network: true, ObjectTypeIP4NetworkTemplate: true, ObjectTypeIP4Address: true,
+	ObjectTypeIP4IPGroup: true, ObjectTypeIP6Block: true, ObjectTypeIP6Network: true, ObjectTypeIP6Address: true,
+	ObjectTypeDHCP4Range: true, ObjectTypeDHCP6Range: true, ObjectTypeMACPool: true, ObjectTypeDenyMACPool: true,
+	ObjectTypeMACAddress: true, ObjectTypeTagGroup: true, ObjectTypeTag: true, ObjectTypeUser: true,
+	ObjectTypeUserGroup: true, ObjectTypeServer: true, ObjectTypeServerGroup: true,
+	ObjectTypeNetworkServerInterface: true, ObjectTypePublishedServerInterface: true,
+	ObjectTypeNetworkInterface: true, ObjectTypeVirtualInterface: true, ObjectTypeLDAP: true,
+	ObjectTypeKerberos: true, ObjectTypeKerberosRealm: true, ObjectTypeRadius: true, ObjectTypeTFTPGroup: true,
+	ObjectTypeTFTPFolder: true, ObjectTypeTFTPFile: true, ObjectTypeTFTPDeploymentRole: true,
+	ObjectTypeDNSDeploymentRole: true, ObjectTypeDHCPDeploymentRole: true, ObjectTypeDNSOption: true,
+	ObjectTypeDNSRawOption: true, ObjectTypeDHCPV4ClientOption: true, ObjectTypeDHCPServiceOption: true,
+	ObjectTypeDHCPRawOption: true, ObjectTypeDHCPV6ClientOption: true, ObjectTypeDHCPV6ServiceOption: true,
+	ObjectTypeDHCPV6RawOption: true, ObjectTypeVendorProfile: true, ObjectTypeVendorOptionDef: true,
+	ObjectTypeVendorClientOption: true, ObjectTypeCustomOptionDef: true, ObjectTypeDHCPMatchClass: true,
+	ObjectTypeDHCPSubClass: true, ObjectTypeDevice: true, ObjectTypeDeviceType: true,
+	ObjectTypeDeviceSubtype: true, ObjectTypeDeploymentScheduler: true, ObjectTypeIP4ReconciliationPolicy: true,
+	ObjectTypeDNSSECSigningPolicy: true, ObjectTypeTSIGKey: true, ObjectTypeACL: true,
+	ObjectTypeResponsePolicy: true, ObjectTypeLocation: true, ObjectTypeInterfaceID: true,
+}
+
+// Valid reports whether t is one of the object type constants. The methods that take an ObjectType do not call Valid,
+// so that object types without a constant can still be used; call it to check a type read from user input.
+func (t ObjectType) Valid() bool {
+	return objectTypes[t]
+}
+
+// validate returns an error wrapping ErrInvalidArgument if t is empty.
+func (t ObjectType) validate() error {
+	if t == "" {
+		return fmt.Errorf("%w - empty object type", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// OptionType is the type of a deployment option, as used by GetDeploymentOptions.
+type OptionType string
+
+// Deployment option types.
+const (
+	OptionTypeDNSOption           OptionType = "DNSOption"
+	OptionTypeDNSRawOption        OptionType = "DNSRawOption"
+	OptionTypeDHCPRawOption       OptionType = "DHCPRawOption"
+	OptionTypeDHCPV6RawOption     OptionType = "DHCPV6RawOption"
+	OptionTypeDHCPV4ClientOption  OptionType = "DHCPV4ClientOption"
+	OptionTypeDHCPV6ClientOption  OptionType = "DHCPV6ClientOption"
+	OptionTypeDHCPServiceOption   OptionType = "DHCPServiceOption"
+	OptionTypeDHCPV6ServiceOption OptionType = "DHCPV6ServiceOption"
+	OptionTypeVendorClientOption  OptionType = "VendorClientOption"
+	OptionTypeStartOfAuthority    OptionType = "StartOfAuthority"
+)
+
+// Valid reports whether t is one of the deployment option type constants.
+func (t OptionType) Valid() bool {
+	switch t {
+	case OptionTypeDNSOption, OptionTypeDNSRawOption, OptionTypeDHCPRawOption, OptionTypeDHCPV6RawOption,
+		OptionTypeDHCPV4ClientOption, OptionTypeDHCPV6ClientOption, OptionTypeDHCPServiceOption,
+		OptionTypeDHCPV6ServiceOption, OptionTypeVendorClientOption, OptionTypeStartOfAuthority:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if t is not a known deployment option type.
+func (t OptionType) validate() error {
+	if !t.Valid() {
+		return fmt.Errorf("%w - unknown deployment option type %q", ErrInvalidArgument, string(t))
+	}
+
+	return nil
+}
+
+// AccessRight is the access level of an access right.
+type AccessRight string
+
+// Access right values.
+const (
+	AccessHide   AccessRight = "HIDE"
+	AccessView   AccessRight = "VIEW"
+	AccessAdd    AccessRight = "ADD"
+	AccessChange AccessRight = "CHANGE"
+	AccessFull   AccessRight = "FULL"
+)
+
+// Valid reports whether a is one of the access right constants.
+func (a AccessRight) Valid() bool {
+	switch a {
+	case AccessHide, AccessView, AccessAdd, AccessChange, AccessFull:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if a is not a known access right.
+func (a AccessRight) validate() error {
+	if !a.Valid() {
+		return fmt.Errorf("%w - unknown access right %q", ErrInvalidArgument, string(a))
+	}
+
+	return nil
+}
+
+// OptionValueType is the type of the value of a custom DHCP option definition or a vendor option definition.
+type OptionValueType string
+
+// Option value types.
+const (
+	OptionValueIP4          OptionValueType = "IP4"
+	OptionValueIP4Mask      OptionValueType = "IP4_MASK"
+	OptionValueText         OptionValueType = "TEXT"
+	OptionValueString       OptionValueType = "STRING"
+	OptionValueBoolean      OptionValueType = "BOOLEAN"
+	OptionValueUint8        OptionValueType = "UINT8"
+	OptionValueUint16       OptionValueType = "UINT16"
+	OptionValueUint32       OptionValueType = "UINT32"
+	OptionValueInt8         OptionValueType = "INT8"
+	OptionValueInt16        OptionValueType = "INT16"
+	OptionValueInt32        OptionValueType = "INT32"
+	OptionValueEncapsulated OptionValueType = "ENCAPSULATED"
+	OptionValueBinary       OptionValueType = "BINARY"
+)
+
+// Valid reports whether t is one of the option value type constants.
+func (t OptionValueType) Valid() bool {
+	switch t {
+	case OptionValueIP4, OptionValueIP4Mask, OptionValueText, OptionValueString, OptionValueBoolean,
+		OptionValueUint8, OptionValueUint16, OptionValueUint32, OptionValueInt8, OptionValueInt16,
+		OptionValueInt32, OptionValueEncapsulated, OptionValueBinary:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if t is not a known option value type.
+func (t OptionValueType) validate() error {
+	if !t.Valid() {
+		return fmt.Errorf("%w - unknown option value type %q", ErrInvalidArgument, string(t))
+	}
+
+	return nil
+}
+
+// MatchCriteria is the part of a DHCP request that a DHCP match class matches on.
+type MatchCriteria string
+
+// DHCP match class criteria.
+const (
+	MatchHardware                MatchCriteria = "MATCH_HARDWARE"
+	MatchClientID                MatchCriteria = "MATCH_DHCP_CLIENT_ID"
+	MatchVendorID                MatchCriteria = "MATCH_DHCP_VENDOR_ID"
+	MatchAgentCircuitID          MatchCriteria = "MATCH_AGENT_CIRCUIT_ID"
+	MatchAgentRemoteID           MatchCriteria = "MATCH_AGENT_REMOTE_ID"
+	MatchAgentCircuitAndRemoteID MatchCriteria = "MATCH_AGENT_CIRCUIT_ID_AND_REMOTE_ID"
+	MatchCustom                  MatchCriteria = "CUSTOM_MATCH"
+	MatchCustomIf                MatchCriteria = "CUSTOM_MATCH_IF"
+)
+
+// Valid reports whether c is one of the match criteria constants.
+func (c MatchCriteria) Valid() bool {
+	switch c {
+	case MatchHardware, MatchClientID, MatchVendorID, MatchAgentCircuitID, MatchAgentRemoteID,
+		MatchAgentCircuitAndRemoteID, MatchCustom, MatchCustomIf:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if c is not a known match criteria.
+func (c MatchCriteria) validate() error {
+	if !c.Valid() {
+		return fmt.Errorf("%w - unknown match criteria %q", ErrInvalidArgument, string(c))
+	}
+
+	return nil
+}
+
+// DNSRoleType is the type of a DNS deployment role, as used by AddDNSDeploymentRole and the Type field of a DNS
+// APIDeploymentRole.
+type DNSRoleType string
+
+// DNS deployment role types.
+const (
+	DNSRoleNone             DNSRoleType = "NONE"
+	DNSRolePrimary          DNSRoleType = "MASTER"
+	DNSRoleHiddenPrimary    DNSRoleType = "MASTER_HIDDEN"
+	DNSRoleSecondary        DNSRoleType = "SLAVE"
+	DNSRoleStealthSecondary DNSRoleType = "SLAVE_STEALTH"
+	DNSRoleForwarder        DNSRoleType = "FORWARDER"
+	DNSRoleStub             DNSRoleType = "STUB"
+	DNSRoleRecursion        DNSRoleType = "RECURSION"
+	DNSRolePeer             DNSRoleType = "PEER"
+	DNSRoleADPrimary        DNSRoleType = "AD_MASTER"
+)
+
+// Valid reports whether t is one of the DNS deployment role type constants.
+func (t DNSRoleType) Valid() bool {
+	switch t {
+	case DNSRoleNone, DNSRolePrimary, DNSRoleHiddenPrimary, DNSRoleSecondary, DNSRoleStealthSecondary,
+		DNSRoleForwarder, DNSRoleStub, DNSRoleRecursion, DNSRolePeer, DNSRoleADPrimary:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if t is not a known DNS deployment role type.
+func (t DNSRoleType) validate() error {
+	if !t.Valid() {
+		return fmt.Errorf("%w - unknown DNS deployment role type %q", ErrInvalidArgument, string(t))
+	}
+
+	return nil
+}
+
+// DHCPRoleType is the type of a DHCP deployment role, as used by AddDHCPDeploymentRole and the Type field of a DHCP
+// APIDeploymentRole. A failover pair is a DHCPRoleMaster role with the secondaryServerInterfaceId property set, see
+// AddDHCPFailoverDeploymentRole.
+type DHCPRoleType string
+
+// DHCP deployment role types.
+const (
+	DHCPRoleNone   DHCPRoleType = "NONE"
+	DHCPRoleMaster DHCPRoleType = "MASTER"
+)
+
+// Valid reports whether t is one of the DHCP deployment role type constants.
+func (t DHCPRoleType) Valid() bool {
+	switch t {
+	case DHCPRoleNone, DHCPRoleMaster:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if t is not a known DHCP deployment role type.
+func (t DHCPRoleType) validate() error {
+	if !t.Valid() {
+		return fmt.Errorf("%w - unknown DHCP deployment role type %q", ErrInvalidArgument, string(t))
+	}
+
+	return nil
+}
+
+// TraversalMethod is the search algorithm used by GetNextAvailableIPRange and GetNextAvailableIPRanges, set
+// through the traversalMethod property.
+type TraversalMethod string
+
+// Traversal methodologies.
+const (
+	TraversalNone         TraversalMethod = "NO_TRAVERSAL"
+	TraversalDepthFirst   TraversalMethod = "DEPTH_FIRST"
+	TraversalBreadthFirst TraversalMethod = "BREADTH_FIRST"
+)
+
+// SearchCategory is the category of objects searched by SearchByCategory.
+type SearchCategory string
+
+// Search categories.
+const (
+	CategoryAll               SearchCategory = "ALL"
+	CategoryAdmin             SearchCategory = "ADMIN"
+	CategoryConfiguration     SearchCategory = "CONFIGURATION"
+	CategoryDeploymentOptions SearchCategory = "DEPLOYMENT_OPTIONS"
+	CategoryDeploymentRoles   SearchCategory = "DEPLOYMENT_ROLES"
+	CategoryDevices           SearchCategory = "DEVICES"
+	CategoryDHCPZones         SearchCategory = "DHCPZONES"
+	CategoryGSS               SearchCategory = "GSS"
+	CategoryIP4Objects        SearchCategory = "IP4_OBJECTS"
+	CategoryIP6Objects        SearchCategory = "IP6_OBJECTS"
+	CategoryMACPoolObjects    SearchCategory = "MACPOOL_OBJECTS"
+	CategoryResourceRecords   SearchCategory = "RESOURCE_RECORDS"
+	CategoryServers           SearchCategory = "SERVERS"
+	CategoryTags              SearchCategory = "TAGS"
+	CategoryTasks             SearchCategory = "TASKS"
+	CategoryTemplates         SearchCategory = "TEMPLATES"
+	CategoryTFTP              SearchCategory = "TFTP"
+	CategoryUserDefinedFields SearchCategory = "USER_DEFINED_FIELDS"
+	CategoryVendorProfiles    SearchCategory = "VENDOR_PROFILES"
+	CategoryViewsZones        SearchCategory = "VIEWS_ZONES"
+)
+
+// Valid reports whether c is one of the search category constants.
+func (c SearchCategory) Valid() bool {
+	switch c {
+	case CategoryAll, CategoryAdmin, CategoryConfiguration, CategoryDeploymentOptions, CategoryDeploymentRoles,
+		CategoryDevices, CategoryDHCPZones, CategoryGSS, CategoryIP4Objects, CategoryIP6Objects,
+		CategoryMACPoolObjects, CategoryResourceRecords, CategoryServers, CategoryTags, CategoryTasks,
+		CategoryTemplates, CategoryTFTP, CategoryUserDefinedFields, CategoryVendorProfiles, CategoryViewsZones:
+		return true
+	}
+
+	return false
+}
+
+// validate returns an error wrapping ErrInvalidArgument if c is not a known search category.
+func (c SearchCategory) validate() error {
+	if !c.Valid() {
+		return fmt.Errorf("%w - unknown search category %q", ErrInvalidArgument, string(c))
+	}
+
+	return nil
+}
+
+// IPAddressState is the allocation state of an IPv4 or IPv6 address, as reported in the state property.
+type IPAddressState string
+
+// IP address states.
+const (
+	StateUnallocated   IPAddressState = "UNALLOCATED"
+	StateStatic        IPAddressState = "STATIC"
+	StateReserved      IPAddressState = "RESERVED"
+	StateGateway       IPAddressState = "GATEWAY"
+	StateDHCPAllocated IPAddressState = "DHCP_ALLOCATED"
+	StateDHCPReserved  IPAddressState = "DHCP_RESERVED"
+	StateDHCPFree      IPAddressState = "DHCP_FREE"
+)
+
+// IPAddressAction is the action used to allocate an IP address, e.g. by assignIP4Address.
+type IPAddressAction string
+
+// IP address allocation actions.
+const (
+	ActionMakeStatic       IPAddressAction = "MAKE_STATIC"
+	ActionMakeReserved     IPAddressAction = "MAKE_RESERVED"
+	ActionMakeDHCPReserved IPAddressAction = "MAKE_DHCP_RESERVED"
+)
@@ -0,0 +1,37 @@
+package bluecat
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// Credentials supplies the username and password used to log in to the Bluecat server. It is called every time
+// the session logs in, including when it re-authenticates after the token has expired, so rotated secrets are
+// picked up without creating a new session.
+type Credentials func(ctx context.Context) (user, pass string, err error)
+
+// StaticCredentials returns Credentials that always log in with the given username and password.
+func StaticCredentials(user, pass string) Credentials {
+	return func(ctx context.Context) (string, string, error) {
+		return user, pass, nil
+	}
+}
+
+// EnvCredentials returns Credentials that read the username and password from the environment variables
+// `uservar` and `passvar` each time the session logs in.
+func EnvCredentials(uservar, passvar string) Credentials {
+	return func(ctx context.Context) (string, string, error) {
+		user, ok := os.LookupEnv(uservar)
+		if !ok || user == "" {
+			return "", "", fmt.Errorf("environment variable %s is not set - EnvCredentials", uservar)
+		}
+
+		pass, ok := os.LookupEnv(passvar)
+		if !ok {
+			return "", "", fmt.Errorf("environment variable %s is not set - EnvCredentials", passvar)
+		}
+
+		return user, pass, nil
+	}
+}
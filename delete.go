@@ -0,0 +1,421 @@
+package bluecat
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Delete deletes an object and all of its children.
+//
+// Parameter `objectid` is the object ID of the object to delete.
+func (b *Bluecat) Delete(objectid int) error {
+	return b.DeleteContext(context.Background(), objectid)
+}
+
+// DeleteContext performs Delete using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteContext(ctx context.Context, objectid int) error {
+	params := url.Values{
+		"objectId": {strconv.Itoa(objectid)},
+	}
+
+	if _, err := b.delete(ctx, "delete", params); err != nil {
+		return fmt.Errorf("%w - Delete request", err)
+	}
+
+	return nil
+}
+
+// DeleteAccessRight deletes an access right for an object.
+//
+// Parameter `entityid` is the object ID of the object to which the access right is assigned, or 0 for a system-level
+// access right. Parameter `userid` is the object ID of the user or group to which the access right applies.
+func (b *Bluecat) DeleteAccessRight(entityid, userid int) error {
+	return b.DeleteAccessRightContext(context.Background(), entityid, userid)
+}
+
+// DeleteAccessRightContext performs DeleteAccessRight using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteAccessRightContext(ctx context.Context, entityid, userid int) error {
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"userId":   {strconv.Itoa(userid)},
+	}
+
+	if _, err := b.delete(ctx, "deleteAccessRight", params); err != nil {
+		return fmt.Errorf("%w - DeleteAccessRight request", err)
+	}
+
+	return nil
+}
+
+// DeleteCustomOptionDefinition deletes a custom option definition. The object is read first, and nothing is deleted if
+// it is not a custom option definition.
+//
+// Parameter `definitionid` is the object ID of the custom option definition to delete.
+func (b *Bluecat) DeleteCustomOptionDefinition(definitionid int) error {
+	return b.DeleteCustomOptionDefinitionContext(context.Background(), definitionid)
+}
+
+// DeleteCustomOptionDefinitionContext performs DeleteCustomOptionDefinition using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteCustomOptionDefinitionContext(ctx context.Context, definitionid int) error {
+	if err := b.deleteTyped(ctx, definitionid, ObjectTypeCustomOptionDef); err != nil {
+		return fmt.Errorf("%w - DeleteCustomOptionDefinition", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCP6ClientDeploymentOption deletes a DHCPv6 client deployment option from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the option is assigned. Parameter `name` is the name
+// of the option. Parameter `serverid` is the object ID of the server or server group to which the option is limited,
+// or 0 for an option that is not limited to a server.
+func (b *Bluecat) DeleteDHCP6ClientDeploymentOption(entityid int, name string, serverid int) error {
+	return b.DeleteDHCP6ClientDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// DeleteDHCP6ClientDeploymentOptionContext performs DeleteDHCP6ClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCP6ClientDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) error {
+	if err := b.deleteDeploymentOption(ctx, "deleteDHCP6ClientDeploymentOption", entityid, name, serverid); err != nil {
+		return fmt.Errorf("%w - DeleteDHCP6ClientDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCP6ServiceDeploymentOption deletes a DHCPv6 service deployment option from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the option is assigned. Parameter `name` is the name
+// of the option. Parameter `serverid` is the object ID of the server or server group to which the option is limited,
+// or 0 for an option that is not limited to a server.
+func (b *Bluecat) DeleteDHCP6ServiceDeploymentOption(entityid int, name string, serverid int) error {
+	return b.DeleteDHCP6ServiceDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// DeleteDHCP6ServiceDeploymentOptionContext performs DeleteDHCP6ServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCP6ServiceDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) error {
+	if err := b.deleteDeploymentOption(ctx, "deleteDHCP6ServiceDeploymentOption", entityid, name, serverid); err != nil {
+		return fmt.Errorf("%w - DeleteDHCP6ServiceDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCPClientDeploymentOption deletes a DHCPv4 client deployment option from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the option is assigned. Parameter `name` is the name
+// of the option. Parameter `serverid` is the object ID of the server or server group to which the option is limited,
+// or 0 for an option that is not limited to a server.
+func (b *Bluecat) DeleteDHCPClientDeploymentOption(entityid int, name string, serverid int) error {
+	return b.DeleteDHCPClientDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// DeleteDHCPClientDeploymentOptionContext performs DeleteDHCPClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCPClientDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) error {
+	if err := b.deleteDeploymentOption(ctx, "deleteDHCPClientDeploymentOption", entityid, name, serverid); err != nil {
+		return fmt.Errorf("%w - DeleteDHCPClientDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCPDeploymentRole deletes the DHCP deployment role of a server interface from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the role is assigned. Parameter `serverinterfaceid`
+// is the object ID of the server interface to which the role is assigned.
+func (b *Bluecat) DeleteDHCPDeploymentRole(entityid, serverinterfaceid int) error {
+	return b.DeleteDHCPDeploymentRoleContext(context.Background(), entityid, serverinterfaceid)
+}
+
+// DeleteDHCPDeploymentRoleContext performs DeleteDHCPDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCPDeploymentRoleContext(ctx context.Context, entityid, serverinterfaceid int) error {
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+	}
+
+	if _, err := b.delete(ctx, "deleteDHCPDeploymentRole", params); err != nil {
+		return fmt.Errorf("%w - DeleteDHCPDeploymentRole request", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCPMatchClass deletes a DHCP match class. Its sub-classes and options are deleted with it. The object is read
+// first, and nothing is deleted if it is not a DHCP match class.
+//
+// Parameter `matchclassid` is the object ID of the DHCP match class to delete.
+func (b *Bluecat) DeleteDHCPMatchClass(matchclassid int) error {
+	return b.DeleteDHCPMatchClassContext(context.Background(), matchclassid)
+}
+
+// DeleteDHCPMatchClassContext performs DeleteDHCPMatchClass using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCPMatchClassContext(ctx context.Context, matchclassid int) error {
+	if err := b.deleteTyped(ctx, matchclassid, ObjectTypeDHCPMatchClass); err != nil {
+		return fmt.Errorf("%w - DeleteDHCPMatchClass", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCPServiceDeploymentOption deletes a DHCPv4 service deployment option from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the option is assigned. Parameter `name` is the name
+// of the option. Parameter `serverid` is the object ID of the server or server group to which the option is limited,
+// or 0 for an option that is not limited to a server.
+func (b *Bluecat) DeleteDHCPServiceDeploymentOption(entityid int, name string, serverid int) error {
+	return b.DeleteDHCPServiceDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// DeleteDHCPServiceDeploymentOptionContext performs DeleteDHCPServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCPServiceDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) error {
+	if err := b.deleteDeploymentOption(ctx, "deleteDHCPServiceDeploymentOption", entityid, name, serverid); err != nil {
+		return fmt.Errorf("%w - DeleteDHCPServiceDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCPSubClass deletes a DHCP sub-class. The object is read first, and nothing is deleted if it is not a
+// DHCP sub-class.
+//
+// Parameter `subclassid` is the object ID of the DHCP sub-class to delete.
+func (b *Bluecat) DeleteDHCPSubClass(subclassid int) error {
+	return b.DeleteDHCPSubClassContext(context.Background(), subclassid)
+}
+
+// DeleteDHCPSubClassContext performs DeleteDHCPSubClass using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCPSubClassContext(ctx context.Context, subclassid int) error {
+	if err := b.deleteTyped(ctx, subclassid, ObjectTypeDHCPSubClass); err != nil {
+		return fmt.Errorf("%w - DeleteDHCPSubClass", err)
+	}
+
+	return nil
+}
+
+// DeleteDHCPVendorDeploymentOption deletes a DHCP vendor deployment option from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the option is assigned. Parameter `optionid` is the
+// object ID of the vendor option definition. Parameter `serverid` is the object ID of the server or server group to
+// which the option is limited, or 0 for an option that is not limited to a server.
+func (b *Bluecat) DeleteDHCPVendorDeploymentOption(entityid, optionid, serverid int) error {
+	return b.DeleteDHCPVendorDeploymentOptionContext(context.Background(), entityid, optionid, serverid)
+}
+
+// DeleteDHCPVendorDeploymentOptionContext performs DeleteDHCPVendorDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDHCPVendorDeploymentOptionContext(ctx context.Context, entityid, optionid, serverid int) error {
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"optionId": {strconv.Itoa(optionid)},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+
+	if _, err := b.delete(ctx, "deleteDHCPVendorDeploymentOption", params); err != nil {
+		return fmt.Errorf("%w - DeleteDHCPVendorDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// DeleteDNSDeploymentRole deletes the DNS deployment role of a server interface from an object.
+//
+// Parameter `entityid` is the object ID of the object to which the role is assigned. Parameter `serverinterfaceid`
+// is the object ID of the server interface to which the role is assigned.
+func (b *Bluecat) DeleteDNSDeploymentRole(entityid, serverinterfaceid int) error {
+	return b.DeleteDNSDeploymentRoleContext(context.Background(), entityid, serverinterfaceid)
+}
+
+// DeleteDNSDeploymentRoleContext performs DeleteDNSDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDNSDeploymentRoleContext(ctx context.Context, entityid, serverinterfaceid int) error {
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+	}
+
+	if _, err := b.delete(ctx, "deleteDNSDeploymentRole", params); err != nil {
+		return fmt.Errorf("%w - DeleteDNSDeploymentRole request", err)
+	}
+
+	return nil
+}
+
+// DeleteDNSDeploymentRoleForView deletes the DNS deployment role of a server interface from an object. Use it for roles
+// on a block or network, which are assigned per view.
+//
+// Parameter `entityid` is the object ID of the object to which the role is assigned. Parameter `serverinterfaceid`
+// is the object ID of the server interface to which the role is assigned. Parameter `viewid` is the object ID of the
+// view for which the role is assigned.
+func (b *Bluecat) DeleteDNSDeploymentRoleForView(entityid, serverinterfaceid, viewid int) error {
+	return b.DeleteDNSDeploymentRoleForViewContext(context.Background(), entityid, serverinterfaceid, viewid)
+}
+
+// DeleteDNSDeploymentRoleForViewContext performs DeleteDNSDeploymentRoleForView using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteDNSDeploymentRoleForViewContext(ctx context.Context, entityid, serverinterfaceid, viewid int) error {
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+		"viewId":            {strconv.Itoa(viewid)},
+	}
+
+	if _, err := b.delete(ctx, "deleteDNSDeploymentRoleForView", params); err != nil {
+		return fmt.Errorf("%w - DeleteDNSDeploymentRoleForView request", err)
+	}
+
+	return nil
+}
+
+// DeleteEnumNumber deletes an ENUM number. The object is read first, and nothing is deleted if it is not an
+// ENUM number.
+//
+// Parameter `enumnumberid` is the object ID of the ENUM number to delete.
+func (b *Bluecat) DeleteEnumNumber(enumnumberid int) error {
+	return b.DeleteEnumNumberContext(context.Background(), enumnumberid)
+}
+
+// DeleteEnumNumberContext performs DeleteEnumNumber using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteEnumNumberContext(ctx context.Context, enumnumberid int) error {
+	if err := b.deleteTyped(ctx, enumnumberid, ObjectTypeEnumNumber); err != nil {
+		return fmt.Errorf("%w - DeleteEnumNumber", err)
+	}
+
+	return nil
+}
+
+// DeleteEnumZone deletes an ENUM zone. The object is read first, and nothing is deleted if it is not an
+// ENUM zone.
+//
+// Parameter `enumzoneid` is the object ID of the ENUM zone to delete.
+func (b *Bluecat) DeleteEnumZone(enumzoneid int) error {
+	return b.DeleteEnumZoneContext(context.Background(), enumzoneid)
+}
+
+// DeleteEnumZoneContext performs DeleteEnumZone using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteEnumZoneContext(ctx context.Context, enumzoneid int) error {
+	if err := b.deleteTyped(ctx, enumzoneid, ObjectTypeEnumZone); err != nil {
+		return fmt.Errorf("%w - DeleteEnumZone", err)
+	}
+
+	return nil
+}
+
+// DeleteExternalHostRecord deletes an external host record. The object is read first, and nothing is deleted if it is
+// not an external host record.
+//
+// Parameter `externalhostrecordid` is the object ID of the external host record to delete.
+func (b *Bluecat) DeleteExternalHostRecord(externalhostrecordid int) error {
+	return b.DeleteExternalHostRecordContext(context.Background(), externalhostrecordid)
+}
+
+// DeleteExternalHostRecordContext performs DeleteExternalHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteExternalHostRecordContext(ctx context.Context, externalhostrecordid int) error {
+	if err := b.deleteTyped(ctx, externalhostrecordid, ObjectTypeExternalHostRecord); err != nil {
+		return fmt.Errorf("%w - DeleteExternalHostRecord", err)
+	}
+
+	return nil
+}
+
+// DeleteHostRecord deletes a host record. The object is read first, and nothing is deleted if it is not a host
+// record.
+//
+// Parameter `hostrecordid` is the object ID of the host record to delete.
+func (b *Bluecat) DeleteHostRecord(hostrecordid int) error {
+	return b.DeleteHostRecordContext(context.Background(), hostrecordid)
+}
+
+// DeleteHostRecordContext performs DeleteHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteHostRecordContext(ctx context.Context, hostrecordid int) error {
+	if err := b.deleteTyped(ctx, hostrecordid, ObjectTypeHostRecord); err != nil {
+		return fmt.Errorf("%w - DeleteHostRecord", err)
+	}
+
+	return nil
+}
+
+// DeleteRange deletes an IPv4 or IPv6 DHCP range. The object is read first, and nothing is deleted if it is not a
+// DHCP range. The addresses of the range are kept in the parent network.
+//
+// Parameter `rangeid` is the object ID of the DHCP range to delete.
+func (b *Bluecat) DeleteRange(rangeid int) error {
+	return b.DeleteRangeContext(context.Background(), rangeid)
+}
+
+// DeleteRangeContext performs DeleteRange using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteRangeContext(ctx context.Context, rangeid int) error {
+	if err := b.deleteTyped(ctx, rangeid, ObjectTypeDHCP4Range, ObjectTypeDHCP6Range); err != nil {
+		return fmt.Errorf("%w - DeleteRange", err)
+	}
+
+	return nil
+}
+
+// DeleteVendorOptionDefinition deletes a vendor option definition. The object is read first, and nothing is deleted if
+// it is not a vendor option definition.
+//
+// Parameter `definitionid` is the object ID of the vendor option definition to delete.
+func (b *Bluecat) DeleteVendorOptionDefinition(definitionid int) error {
+	return b.DeleteVendorOptionDefinitionContext(context.Background(), definitionid)
+}
+
+// DeleteVendorOptionDefinitionContext performs DeleteVendorOptionDefinition using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteVendorOptionDefinitionContext(ctx context.Context, definitionid int) error {
+	if err := b.deleteTyped(ctx, definitionid, ObjectTypeVendorOptionDef); err != nil {
+		return fmt.Errorf("%w - DeleteVendorOptionDefinition", err)
+	}
+
+	return nil
+}
+
+// DeleteVendorProfile deletes a vendor profile. The option definitions of the profile are
+// deleted with it. The object is read first, and nothing is deleted if it is
+// not a vendor profile.
+//
+// Parameter `vendorprofileid` is the object ID of the vendor profile to delete.
+func (b *Bluecat) DeleteVendorProfile(vendorprofileid int) error {
+	return b.DeleteVendorProfileContext(context.Background(), vendorprofileid)
+}
+
+// DeleteVendorProfileContext performs DeleteVendorProfile using ctx to cancel or time out the request.
+func (b *Bluecat) DeleteVendorProfileContext(ctx context.Context, vendorprofileid int) error {
+	if err := b.deleteTyped(ctx, vendorprofileid, ObjectTypeVendorProfile); err != nil {
+		return fmt.Errorf("%w - DeleteVendorProfile", err)
+	}
+
+	return nil
+}
+
+// deleteTyped reads the object `objectid` and deletes it if it is of one of the types `objecttypes`.
+func (b *Bluecat) deleteTyped(ctx context.Context, objectid int, objecttypes ...ObjectType) error {
+	entity, err := b.GetEntityByIDContext(ctx, objectid)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(objecttypes))
+	for i, objecttype := range objecttypes {
+		if ObjectType(entity.Type) == objecttype {
+			return b.DeleteContext(ctx, objectid)
+		}
+		names[i] = string(objecttype)
+	}
+
+	return fmt.Errorf("%w - object %d is of type %s, not %s", ErrInvalidArgument, objectid, entity.Type, strings.Join(names, " or "))
+}
+
+// deleteDeploymentOption deletes a deployment option through the API method `call`.
+func (b *Bluecat) deleteDeploymentOption(ctx context.Context, call string, entityid int, name string, serverid int) error {
+	if name == "" {
+		return fmt.Errorf("%w - empty option name", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"name":     {name},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	_, err := b.delete(ctx, call, params)
+
+	return err
+}
@@ -0,0 +1,157 @@
+package bluecat
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DHCPOption is a DHCPv4 deployment option name with its value encoded in the format expected by Address Manager.
+// The encoders below build the common options, which are then added with e.g.
+//
+//	opt, err := bluecat.RouterOption("10.0.0.1")
+//	id, err := bc.AddDHCPClientDeploymentOption(networkid, opt.Name, opt.Value, "")
+type DHCPOption struct {
+	Name  string
+	Value string
+}
+
+// DHCPv4 option names used by the encoders.
+const (
+	DHCPOptionRouter                    = "router"
+	DHCPOptionDomainNameServer          = "domain-name-server"
+	DHCPOptionDomainName                = "domain-name"
+	DHCPOptionLeaseTime                 = "dhcp-lease-time"
+	DHCPOptionVendorEncapsulatedOptions = "vendor-encapsulated-options"
+	DHCPOptionDefaultLeaseTime          = "default-lease-time"
+	DHCPOptionMaxLeaseTime              = "max-lease-time"
+)
+
+// RouterOption encodes option 3, the default gateways of a network, as a DHCP client option.
+func RouterOption(routers ...string) (DHCPOption, error) {
+	value, err := ip4List(routers)
+	if err != nil {
+		return DHCPOption{}, fmt.Errorf("%w - RouterOption", err)
+	}
+
+	return DHCPOption{Name: DHCPOptionRouter, Value: value}, nil
+}
+
+// DomainNameServerOption encodes option 6, the DNS servers of a network, as a DHCP client option.
+func DomainNameServerOption(servers ...string) (DHCPOption, error) {
+	value, err := ip4List(servers)
+	if err != nil {
+		return DHCPOption{}, fmt.Errorf("%w - DomainNameServerOption", err)
+	}
+
+	return DHCPOption{Name: DHCPOptionDomainNameServer, Value: value}, nil
+}
+
+// DomainNameOption encodes option 15, the domain name of a network, as a DHCP client option.
+func DomainNameOption(domain string) (DHCPOption, error) {
+	if domain == "" || strings.ContainsAny(domain, " ,") {
+		return DHCPOption{}, fmt.Errorf("%w - invalid domain name %q - DomainNameOption", ErrInvalidArgument, domain)
+	}
+
+	return DHCPOption{Name: DHCPOptionDomainName, Value: domain}, nil
+}
+
+// LeaseTimeOption encodes option 51, the lease time offered to clients, as a DHCP client option.
+func LeaseTimeOption(d time.Duration) (DHCPOption, error) {
+	value, err := seconds(d)
+	if err != nil {
+		return DHCPOption{}, fmt.Errorf("%w - LeaseTimeOption", err)
+	}
+
+	return DHCPOption{Name: DHCPOptionLeaseTime, Value: value}, nil
+}
+
+// DefaultLeaseTimeOption encodes the lease time used when a client does not ask for one, as a DHCP service option.
+func DefaultLeaseTimeOption(d time.Duration) (DHCPOption, error) {
+	value, err := seconds(d)
+	if err != nil {
+		return DHCPOption{}, fmt.Errorf("%w - DefaultLeaseTimeOption", err)
+	}
+
+	return DHCPOption{Name: DHCPOptionDefaultLeaseTime, Value: value}, nil
+}
+
+// MaxLeaseTimeOption encodes the longest lease time granted to a client, as a DHCP service option.
+func MaxLeaseTimeOption(d time.Duration) (DHCPOption, error) {
+	value, err := seconds(d)
+	if err != nil {
+		return DHCPOption{}, fmt.Errorf("%w - MaxLeaseTimeOption", err)
+	}
+
+	return DHCPOption{Name: DHCPOptionMaxLeaseTime, Value: value}, nil
+}
+
+// VendorEncapsulatedOptions encodes option 43 as a DHCP client option. The sub-options are encoded as code, length
+// and data, in ascending order of their code, and written as colon-separated hexadecimal bytes, e.g.
+// "01:04:0a:00:00:01" for sub-option 1 with the data 10.0.0.1.
+//
+// The codes 0 and 255 are reserved for padding and the end marker and cannot be used.
+func VendorEncapsulatedOptions(suboptions map[byte][]byte) (DHCPOption, error) {
+	if len(suboptions) == 0 {
+		return DHCPOption{}, fmt.Errorf("%w - no sub-options - VendorEncapsulatedOptions", ErrInvalidArgument)
+	}
+
+	codes := make([]int, 0, len(suboptions))
+	for code := range suboptions {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+
+	var blob []byte
+	for _, code := range codes {
+		data := suboptions[byte(code)]
+		if code == 0 || code == 255 {
+			return DHCPOption{}, fmt.Errorf("%w - reserved sub-option code %d - VendorEncapsulatedOptions", ErrInvalidArgument, code)
+		}
+
+		if len(data) > 255 {
+			return DHCPOption{}, fmt.Errorf("%w - sub-option %d is longer than 255 bytes - VendorEncapsulatedOptions", ErrInvalidArgument, code)
+		}
+
+		blob = append(blob, byte(code), byte(len(data)))
+		blob = append(blob, data...)
+	}
+
+	if len(blob) > 255 {
+		return DHCPOption{}, fmt.Errorf("%w - option is longer than 255 bytes - VendorEncapsulatedOptions", ErrInvalidArgument)
+	}
+
+	hex := make([]string, len(blob))
+	for i, c := range blob {
+		hex[i] = fmt.Sprintf("%02x", c)
+	}
+
+	return DHCPOption{Name: DHCPOptionVendorEncapsulatedOptions, Value: strings.Join(hex, ":")}, nil
+}
+
+// ip4List checks that each of addresses is an IPv4 address and joins them with commas.
+func ip4List(addresses []string) (string, error) {
+	if len(addresses) == 0 {
+		return "", fmt.Errorf("%w - no addresses", ErrInvalidArgument)
+	}
+
+	for _, address := range addresses {
+		if _, err := parseIP4(address); err != nil {
+			return "", err
+		}
+	}
+
+	return strings.Join(addresses, ","), nil
+}
+
+// seconds returns d as a whole number of seconds, which must fit in the unsigned 32-bit lease time of DHCP.
+func seconds(d time.Duration) (string, error) {
+	if d < time.Second || d.Seconds() > math.MaxUint32 || d%time.Second != 0 {
+		return "", fmt.Errorf("%w - invalid lease time %s", ErrInvalidArgument, d)
+	}
+
+	return strconv.FormatInt(int64(d/time.Second), 10), nil
+}
@@ -0,0 +1,422 @@
+package bluecat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Entity holds the fields shared by all typed entities. The Properties field contains every property of the
+// object, including the ones that are decoded into the fields of the typed struct.
+type Entity struct {
+	ID         int64
+	Name       string
+	Type       string
+	Properties Properties
+}
+
+// Configuration is an Address Manager configuration, the top level container of all other objects.
+type Configuration struct {
+	Entity
+	Description   string
+	SharedNetwork string
+}
+
+// View is a DNS view.
+type View struct {
+	Entity
+}
+
+// Zone is a DNS zone.
+type Zone struct {
+	Entity
+	AbsoluteName string
+	Deployable   bool
+}
+
+// HostRecord is a DNS host record, which links a name to one or more IP addresses.
+type HostRecord struct {
+	Entity
+	AbsoluteName  string
+	Addresses     []string
+	ReverseRecord bool
+	TTL           int64 // -1 when the record uses the zone default
+}
+
+// AliasRecord is a DNS alias (CNAME) record.
+type AliasRecord struct {
+	Entity
+	AbsoluteName     string
+	LinkedRecordName string
+	TTL              int64 // -1 when the record uses the zone default
+}
+
+// IP4Block is an IPv4 block.
+type IP4Block struct {
+	Entity
+	CIDR  string
+	Start string
+	End   string
+}
+
+// IP4Network is an IPv4 network.
+type IP4Network struct {
+	Entity
+	CIDR    string
+	Gateway string
+}
+
+// IP4Address is an IPv4 address.
+type IP4Address struct {
+	Entity
+	Address    string
+	State      IPAddressState
+	MACAddress string
+}
+
+// DHCP4Range is an IPv4 DHCP range.
+type DHCP4Range struct {
+	Entity
+	Start string
+	End   string
+}
+
+// MACAddress is a MAC address object.
+type MACAddress struct {
+	Entity
+	Address string
+}
+
+// Server is an Address Manager managed server.
+type Server struct {
+	Entity
+	DefaultInterfaceAddress string
+	FullHostName            string
+	Profile                 string
+}
+
+// Device is a device object.
+type Device struct {
+	Entity
+	DeviceTypeID    int64
+	DeviceSubtypeID int64
+	IP4Addresses    []string
+	IP6Addresses    []string
+}
+
+// ACL is a DNS access control list. MatchList holds the match list elements, such as "10.0.0.0/8", "!192.168.0.1"
+// or the name of another ACL.
+type ACL struct {
+	Entity
+	MatchList []string
+}
+
+// entity returns the common fields of e, checking that e is of the type `objecttype`.
+func (e APIEntity) entity(objecttype ObjectType) (Entity, error) {
+	if ObjectType(e.Type) != objecttype {
+		return Entity{}, fmt.Errorf("entity %d is of type %s, not %s", e.ID, e.Type, objecttype)
+	}
+
+	return Entity{
+		ID:         e.ID,
+		Name:       e.Name,
+		Type:       e.Type,
+		Properties: e.PropertyMap(),
+	}, nil
+}
+
+// AsConfiguration converts e, which must be of type Configuration, to a Configuration.
+func (e APIEntity) AsConfiguration() (Configuration, error) {
+	base, err := e.entity(ObjectTypeConfiguration)
+	if err != nil {
+		return Configuration{}, fmt.Errorf("%w - AsConfiguration", err)
+	}
+
+	return Configuration{
+		Entity:        base,
+		Description:   base.Properties["description"],
+		SharedNetwork: base.Properties["sharedNetwork"],
+	}, nil
+}
+
+// AsView converts e, which must be of type View, to a View.
+func (e APIEntity) AsView() (View, error) {
+	base, err := e.entity(ObjectTypeView)
+	if err != nil {
+		return View{}, fmt.Errorf("%w - AsView", err)
+	}
+
+	return View{Entity: base}, nil
+}
+
+// AsZone converts e, which must be of type Zone, to a Zone.
+func (e APIEntity) AsZone() (Zone, error) {
+	base, err := e.entity(ObjectTypeZone)
+	if err != nil {
+		return Zone{}, fmt.Errorf("%w - AsZone", err)
+	}
+
+	deployable, err := boolProperty(base.Properties, "deployable")
+	if err != nil {
+		return Zone{}, fmt.Errorf("%w - AsZone", err)
+	}
+
+	return Zone{
+		Entity:       base,
+		AbsoluteName: base.Properties["absoluteName"],
+		Deployable:   deployable,
+	}, nil
+}
+
+// AsHostRecord converts e, which must be of type HostRecord, to a HostRecord.
+func (e APIEntity) AsHostRecord() (HostRecord, error) {
+	base, err := e.entity(ObjectTypeHostRecord)
+	if err != nil {
+		return HostRecord{}, fmt.Errorf("%w - AsHostRecord", err)
+	}
+
+	ttl, err := ttlProperty(base.Properties)
+	if err != nil {
+		return HostRecord{}, fmt.Errorf("%w - AsHostRecord", err)
+	}
+
+	reverse, err := boolProperty(base.Properties, "reverseRecord")
+	if err != nil {
+		return HostRecord{}, fmt.Errorf("%w - AsHostRecord", err)
+	}
+
+	return HostRecord{
+		Entity:        base,
+		AbsoluteName:  base.Properties["absoluteName"],
+		Addresses:     listProperty(base.Properties, "addresses"),
+		ReverseRecord: reverse,
+		TTL:           ttl,
+	}, nil
+}
+
+// AsAliasRecord converts e, which must be of type AliasRecord, to an AliasRecord.
+func (e APIEntity) AsAliasRecord() (AliasRecord, error) {
+	base, err := e.entity(ObjectTypeAliasRecord)
+	if err != nil {
+		return AliasRecord{}, fmt.Errorf("%w - AsAliasRecord", err)
+	}
+
+	ttl, err := ttlProperty(base.Properties)
+	if err != nil {
+		return AliasRecord{}, fmt.Errorf("%w - AsAliasRecord", err)
+	}
+
+	return AliasRecord{
+		Entity:           base,
+		AbsoluteName:     base.Properties["absoluteName"],
+		LinkedRecordName: base.Properties["linkedRecordName"],
+		TTL:              ttl,
+	}, nil
+}
+
+// AsIP4Block converts e, which must be of type IP4Block, to an IP4Block.
+func (e APIEntity) AsIP4Block() (IP4Block, error) {
+	base, err := e.entity(ObjectTypeIP4Block)
+	if err != nil {
+		return IP4Block{}, fmt.Errorf("%w - AsIP4Block", err)
+	}
+
+	return IP4Block{
+		Entity: base,
+		CIDR:   base.Properties["CIDR"],
+		Start:  base.Properties["start"],
+		End:    base.Properties["end"],
+	}, nil
+}
+
+// AsIP4Network converts e, which must be of type IP4Network, to an IP4Network.
+func (e APIEntity) AsIP4Network() (IP4Network, error) {
+	base, err := e.entity(ObjectTypeIP4Network)
+	if err != nil {
+		return IP4Network{}, fmt.Errorf("%w - AsIP4Network", err)
+	}
+
+	return IP4Network{
+		Entity:  base,
+		CIDR:    base.Properties["CIDR"],
+		Gateway: base.Properties["gateway"],
+	}, nil
+}
+
+// AsIP4Address converts e, which must be of type IP4Address, to an IP4Address.
+func (e APIEntity) AsIP4Address() (IP4Address, error) {
+	base, err := e.entity(ObjectTypeIP4Address)
+	if err != nil {
+		return IP4Address{}, fmt.Errorf("%w - AsIP4Address", err)
+	}
+
+	return IP4Address{
+		Entity:     base,
+		Address:    base.Properties["address"],
+		State:      IPAddressState(base.Properties["state"]),
+		MACAddress: base.Properties["macAddress"],
+	}, nil
+}
+
+// AsDHCP4Range converts e, which must be of type DHCP4Range, to a DHCP4Range.
+func (e APIEntity) AsDHCP4Range() (DHCP4Range, error) {
+	base, err := e.entity(ObjectTypeDHCP4Range)
+	if err != nil {
+		return DHCP4Range{}, fmt.Errorf("%w - AsDHCP4Range", err)
+	}
+
+	return DHCP4Range{
+		Entity: base,
+		Start:  base.Properties["start"],
+		End:    base.Properties["end"],
+	}, nil
+}
+
+// AsMACAddress converts e, which must be of type MACAddress, to a MACAddress.
+func (e APIEntity) AsMACAddress() (MACAddress, error) {
+	base, err := e.entity(ObjectTypeMACAddress)
+	if err != nil {
+		return MACAddress{}, fmt.Errorf("%w - AsMACAddress", err)
+	}
+
+	return MACAddress{
+		Entity:  base,
+		Address: base.Properties["address"],
+	}, nil
+}
+
+// AsServer converts e, which must be of type Server, to a Server.
+func (e APIEntity) AsServer() (Server, error) {
+	base, err := e.entity(ObjectTypeServer)
+	if err != nil {
+		return Server{}, fmt.Errorf("%w - AsServer", err)
+	}
+
+	return Server{
+		Entity:                  base,
+		DefaultInterfaceAddress: base.Properties["defaultInterfaceAddress"],
+		FullHostName:            base.Properties["fullHostName"],
+		Profile:                 base.Properties["profile"],
+	}, nil
+}
+
+// AsDevice converts e, which must be of type Device, to a Device.
+func (e APIEntity) AsDevice() (Device, error) {
+	base, err := e.entity(ObjectTypeDevice)
+	if err != nil {
+		return Device{}, fmt.Errorf("%w - AsDevice", err)
+	}
+
+	typeid, err := intProperty(base.Properties, "deviceTypeId", 0)
+	if err != nil {
+		return Device{}, fmt.Errorf("%w - AsDevice", err)
+	}
+
+	subtypeid, err := intProperty(base.Properties, "deviceSubtypeId", 0)
+	if err != nil {
+		return Device{}, fmt.Errorf("%w - AsDevice", err)
+	}
+
+	return Device{
+		Entity:          base,
+		DeviceTypeID:    typeid,
+		DeviceSubtypeID: subtypeid,
+		IP4Addresses:    listProperty(base.Properties, "ip4Addresses"),
+		IP6Addresses:    listProperty(base.Properties, "ip6Addresses"),
+	}, nil
+}
+
+// AsACL converts e, which must be of type ACL, to an ACL.
+func (e APIEntity) AsACL() (ACL, error) {
+	base, err := e.entity(ObjectTypeACL)
+	if err != nil {
+		return ACL{}, fmt.Errorf("%w - AsACL", err)
+	}
+
+	return ACL{
+		Entity:    base,
+		MatchList: listProperty(base.Properties, "aclValues"),
+	}, nil
+}
+
+// Typed converts e to the typed struct matching its Type, e.g. an IP4Network or a HostRecord. Entities of a type
+// without a dedicated struct are returned as an Entity.
+func (e APIEntity) Typed() (interface{}, error) {
+	switch ObjectType(e.Type) {
+	case ObjectTypeConfiguration:
+		return e.AsConfiguration()
+	case ObjectTypeView:
+		return e.AsView()
+	case ObjectTypeZone:
+		return e.AsZone()
+	case ObjectTypeHostRecord:
+		return e.AsHostRecord()
+	case ObjectTypeAliasRecord:
+		return e.AsAliasRecord()
+	case ObjectTypeIP4Block:
+		return e.AsIP4Block()
+	case ObjectTypeIP4Network:
+		return e.AsIP4Network()
+	case ObjectTypeIP4Address:
+		return e.AsIP4Address()
+	case ObjectTypeDHCP4Range:
+		return e.AsDHCP4Range()
+	case ObjectTypeMACAddress:
+		return e.AsMACAddress()
+	case ObjectTypeServer:
+		return e.AsServer()
+	case ObjectTypeDevice:
+		return e.AsDevice()
+	case ObjectTypeACL:
+		return e.AsACL()
+	}
+
+	return e.entity(ObjectType(e.Type))
+}
+
+// listProperty returns the comma-separated values of the property `name`, or nil if it is not set.
+func listProperty(p Properties, name string) []string {
+	if p[name] == "" {
+		return nil
+	}
+
+	values := strings.Split(p[name], ",")
+	for i, v := range values {
+		values[i] = strings.TrimSpace(v)
+	}
+
+	return values
+}
+
+// intProperty returns the property `name` as an integer, or def if it is not set.
+func intProperty(p Properties, name string, def int64) (int64, error) {
+	if p[name] == "" {
+		return def, nil
+	}
+
+	v, err := strconv.ParseInt(p[name], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s property %q", name, p[name])
+	}
+
+	return v, nil
+}
+
+// boolProperty returns the property `name` as a boolean, or false if it is not set.
+func boolProperty(p Properties, name string) (bool, error) {
+	if p[name] == "" {
+		return false, nil
+	}
+
+	v, err := strconv.ParseBool(p[name])
+	if err != nil {
+		return false, fmt.Errorf("invalid %s property %q", name, p[name])
+	}
+
+	return v, nil
+}
+
+// ttlProperty returns the ttl property of a resource record, or -1 if the record uses the zone default.
+func ttlProperty(p Properties) (int64, error) {
+	return intProperty(p, "ttl", -1)
+}
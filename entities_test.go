@@ -0,0 +1,179 @@
+package bluecat
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTyped(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      APIEntity
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "configuration",
+			in:   APIEntity{ID: 1, Name: "default", Type: "Configuration", Properties: "description=main|sharedNetwork=tag|"},
+			want: Configuration{
+				Entity:        Entity{ID: 1, Name: "default", Type: "Configuration", Properties: Properties{"description": "main", "sharedNetwork": "tag"}},
+				Description:   "main",
+				SharedNetwork: "tag",
+			},
+		},
+		{
+			name: "view",
+			in:   APIEntity{ID: 2, Name: "internal", Type: "View"},
+			want: View{Entity: Entity{ID: 2, Name: "internal", Type: "View", Properties: Properties{}}},
+		},
+		{
+			name: "zone",
+			in:   APIEntity{ID: 3, Name: "example", Type: "Zone", Properties: "absoluteName=example.com|deployable=true|"},
+			want: Zone{
+				Entity:       Entity{ID: 3, Name: "example", Type: "Zone", Properties: Properties{"absoluteName": "example.com", "deployable": "true"}},
+				AbsoluteName: "example.com",
+				Deployable:   true,
+			},
+		},
+		{
+			name: "host record",
+			in:   APIEntity{ID: 4, Name: "web", Type: "HostRecord", Properties: "absoluteName=web.example.com|addresses=10.0.0.10, 10.0.0.11|reverseRecord=true|ttl=3600|"},
+			want: HostRecord{
+				Entity: Entity{ID: 4, Name: "web", Type: "HostRecord", Properties: Properties{
+					"absoluteName": "web.example.com", "addresses": "10.0.0.10, 10.0.0.11", "reverseRecord": "true", "ttl": "3600",
+				}},
+				AbsoluteName:  "web.example.com",
+				Addresses:     []string{"10.0.0.10", "10.0.0.11"},
+				ReverseRecord: true,
+				TTL:           3600,
+			},
+		},
+		{
+			name: "host record without ttl",
+			in:   APIEntity{ID: 4, Name: "web", Type: "HostRecord", Properties: "addresses=10.0.0.10|"},
+			want: HostRecord{
+				Entity:    Entity{ID: 4, Name: "web", Type: "HostRecord", Properties: Properties{"addresses": "10.0.0.10"}},
+				Addresses: []string{"10.0.0.10"},
+				TTL:       -1,
+			},
+		},
+		{
+			name:    "host record with invalid ttl",
+			in:      APIEntity{ID: 4, Name: "web", Type: "HostRecord", Properties: "ttl=1h|"},
+			wantErr: true,
+		},
+		{
+			name:    "host record with invalid reverseRecord",
+			in:      APIEntity{ID: 4, Name: "web", Type: "HostRecord", Properties: "reverseRecord=yes please|"},
+			wantErr: true,
+		},
+		{
+			name: "alias record",
+			in:   APIEntity{ID: 5, Name: "www", Type: "AliasRecord", Properties: "linkedRecordName=web.example.com|ttl=300|"},
+			want: AliasRecord{
+				Entity:           Entity{ID: 5, Name: "www", Type: "AliasRecord", Properties: Properties{"linkedRecordName": "web.example.com", "ttl": "300"}},
+				LinkedRecordName: "web.example.com",
+				TTL:              300,
+			},
+		},
+		{
+			name: "ip4 block",
+			in:   APIEntity{ID: 6, Type: "IP4Block", Properties: "CIDR=10.0.0.0/8|"},
+			want: IP4Block{Entity: Entity{ID: 6, Type: "IP4Block", Properties: Properties{"CIDR": "10.0.0.0/8"}}, CIDR: "10.0.0.0/8"},
+		},
+		{
+			name: "ip4 network",
+			in:   APIEntity{ID: 7, Type: "IP4Network", Properties: "CIDR=10.0.0.0/24|gateway=10.0.0.1|"},
+			want: IP4Network{
+				Entity:  Entity{ID: 7, Type: "IP4Network", Properties: Properties{"CIDR": "10.0.0.0/24", "gateway": "10.0.0.1"}},
+				CIDR:    "10.0.0.0/24",
+				Gateway: "10.0.0.1",
+			},
+		},
+		{
+			name: "ip4 address",
+			in:   APIEntity{ID: 8, Type: "IP4Address", Properties: "address=10.0.0.10|state=STATIC|macAddress=00-11-22-33-44-55|"},
+			want: IP4Address{
+				Entity:     Entity{ID: 8, Type: "IP4Address", Properties: Properties{"address": "10.0.0.10", "state": "STATIC", "macAddress": "00-11-22-33-44-55"}},
+				Address:    "10.0.0.10",
+				State:      StateStatic,
+				MACAddress: "00-11-22-33-44-55",
+			},
+		},
+		{
+			name: "dhcp4 range",
+			in:   APIEntity{ID: 9, Type: "DHCP4Range", Properties: "start=10.0.0.50|end=10.0.0.60|"},
+			want: DHCP4Range{Entity: Entity{ID: 9, Type: "DHCP4Range", Properties: Properties{"start": "10.0.0.50", "end": "10.0.0.60"}}, Start: "10.0.0.50", End: "10.0.0.60"},
+		},
+		{
+			name: "mac address",
+			in:   APIEntity{ID: 10, Type: "MACAddress", Properties: "address=00-11-22-33-44-55|"},
+			want: MACAddress{Entity: Entity{ID: 10, Type: "MACAddress", Properties: Properties{"address": "00-11-22-33-44-55"}}, Address: "00-11-22-33-44-55"},
+		},
+		{
+			name: "server",
+			in:   APIEntity{ID: 11, Name: "dns1", Type: "Server", Properties: "defaultInterfaceAddress=10.0.0.2|fullHostName=dns1.example.com|profile=DNS_DHCP_SERVER_60|"},
+			want: Server{
+				Entity: Entity{ID: 11, Name: "dns1", Type: "Server", Properties: Properties{
+					"defaultInterfaceAddress": "10.0.0.2", "fullHostName": "dns1.example.com", "profile": "DNS_DHCP_SERVER_60",
+				}},
+				DefaultInterfaceAddress: "10.0.0.2",
+				FullHostName:            "dns1.example.com",
+				Profile:                 "DNS_DHCP_SERVER_60",
+			},
+		},
+		{
+			name: "device",
+			in:   APIEntity{ID: 12, Name: "sw1", Type: "Device", Properties: "deviceTypeId=20|ip4Addresses=10.0.0.3,10.0.0.4|"},
+			want: Device{
+				Entity:       Entity{ID: 12, Name: "sw1", Type: "Device", Properties: Properties{"deviceTypeId": "20", "ip4Addresses": "10.0.0.3,10.0.0.4"}},
+				DeviceTypeID: 20,
+				IP4Addresses: []string{"10.0.0.3", "10.0.0.4"},
+			},
+		},
+		{
+			name:    "device with invalid type ID",
+			in:      APIEntity{ID: 12, Name: "sw1", Type: "Device", Properties: "deviceTypeId=switch|"},
+			wantErr: true,
+		},
+		{
+			name: "acl",
+			in:   APIEntity{ID: 13, Name: "trusted", Type: "ACL", Properties: "aclValues=10.0.0.0/8,!192.168.0.1|"},
+			want: ACL{Entity: Entity{ID: 13, Name: "trusted", Type: "ACL", Properties: Properties{"aclValues": "10.0.0.0/8,!192.168.0.1"}}, MatchList: []string{"10.0.0.0/8", "!192.168.0.1"}},
+		},
+		{
+			name: "type without a struct",
+			in:   APIEntity{ID: 14, Name: "tag", Type: "Tag", Properties: "a=1|"},
+			want: Entity{ID: 14, Name: "tag", Type: "Tag", Properties: Properties{"a": "1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.in.Typed()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Typed() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Typed() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeMismatch(t *testing.T) {
+	e := APIEntity{ID: 7, Type: "IP4Network", Properties: "CIDR=10.0.0.0/24|"}
+
+	if _, err := e.AsIP4Block(); err == nil {
+		t.Error("AsIP4Block() of an IP4Network error = nil, want an error")
+	}
+
+	if _, err := e.AsHostRecord(); err == nil {
+		t.Error("AsHostRecord() of an IP4Network error = nil, want an error")
+	}
+
+	if _, err := e.AsIP4Network(); err != nil {
+		t.Errorf("AsIP4Network() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,129 @@
+package bluecat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gopkg.in/resty.v1"
+)
+
+// Sentinel errors that classify the failures returned by the Address Manager. Use errors.Is to test for them,
+// and errors.As with *APIError to get the details of the failed request.
+var (
+	ErrNotFound     = errors.New("object not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrDuplicate    = errors.New("duplicate object")
+	ErrPermission   = errors.New("permission denied")
+
+	// ErrInvalidArgument is returned before any request is sent when an argument, such as an object type, is
+	// not valid.
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+// errInvalidRateLimit is returned by WithRateLimit for a rate or burst that is not positive.
+var errInvalidRateLimit = errors.New("rate and burst must be positive - WithRateLimit option")
+
+// APIError is returned when the Address Manager answers a request with an error.
+type APIError struct {
+	// Method is the name of the API method that was called, e.g. getEntityById.
+	Method string
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Body is the raw body of the response.
+	Body string
+
+	// Message is the error message reported by the Address Manager.
+	Message string
+
+	// Err is one of the sentinel errors of this package when the failure could be classified, otherwise nil.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s - %s response", e.Message, e.Method)
+}
+
+// Unwrap returns the sentinel error that classifies e, so that errors.Is(err, ErrDuplicate) and friends work.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// newAPIError builds an APIError for the failed response of the API method `call`.
+func newAPIError(call string, resp *resty.Response) *APIError {
+	body := resp.String()
+	e := &APIError{
+		Method:     call,
+		StatusCode: resp.StatusCode(),
+		Body:       body,
+		Message:    faultMessage(body),
+	}
+
+	if e.Message == "" {
+		e.Message = http.StatusText(e.StatusCode)
+	}
+	e.Err = classify(e.StatusCode, e.Message)
+
+	return e
+}
+
+// faultMessage extracts the error message from the body of a failed response. The Address Manager either returns
+// the message as plain text or as a JSON encoded string.
+func faultMessage(body string) string {
+	msg := strings.TrimSpace(body)
+
+	var s string
+	if strings.HasPrefix(msg, "\"") && json.Unmarshal([]byte(msg), &s) == nil {
+		msg = strings.TrimSpace(s)
+	}
+
+	return msg
+}
+
+// classify maps an HTTP status code and Address Manager fault message to one of the sentinel errors.
+func classify(status int, msg string) error {
+	switch status {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrPermission
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "duplicate"), strings.Contains(lower, "already exists"):
+		return ErrDuplicate
+	case strings.Contains(lower, "not found"), strings.Contains(lower, "does not exist"):
+		return ErrNotFound
+	case strings.Contains(lower, "permission"), strings.Contains(lower, "access right"),
+		strings.Contains(lower, "not authorized"):
+		return ErrPermission
+	case strings.Contains(lower, "authentication"), strings.Contains(lower, "unauthorized"):
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+// isSuccess reports whether resp has a 2xx status code.
+func isSuccess(resp *resty.Response) bool {
+	return resp.StatusCode() >= 200 && resp.StatusCode() < 300
+}
+
+// notFound returns the error reported when the API method `call` answers with an empty object, which is how the
+// Address Manager signals that the requested object does not exist.
+func notFound(call string) *APIError {
+	return &APIError{
+		Method:     call,
+		StatusCode: http.StatusOK,
+		Message:    "Object was not found",
+		Err:        ErrNotFound,
+	}
+}
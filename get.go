@@ -1,11 +1,12 @@
 package bluecat
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
-
-	"gopkg.in/resty.v1"
 )
 
 // GetEntitiesByName returns an array of entities that match the specified parent, name, and object type.
@@ -17,21 +18,32 @@ import (
 // returning objects. The list begins at an index of 0. This value cannot be null or empty.
 //
 // Returns an array of type APIEntity. The array is empty if there are no matching entities.
-func (b *Bluecat) GetEntitiesByName(name string, parentid int, objecttype string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) GetEntitiesByName(name string, parentid int, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	return b.GetEntitiesByNameContext(context.Background(), name, parentid, objecttype, count, start)
+}
+
+// GetEntitiesByNameContext performs GetEntitiesByName using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntitiesByNameContext(ctx context.Context, name string, parentid int, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetEntitiesByName request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntitiesByName?name=%s&parentId=%d&type=%s&count=%d&start=%d",
-		b.Server, b.URI, name, parentid, objecttype, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"name":     {name},
+		"parentId": {strconv.Itoa(parentid)},
+		"type":     {string(objecttype)},
+		"count":    {strconv.Itoa(count)},
+		"start":    {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getEntitiesByName", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetEntitesByName request", err)
+		return nil, fmt.Errorf("%w - GetEntitesByName request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetEntitiesByName JSON parse", err)
+		return nil, fmt.Errorf("%w - GetEntitiesByName JSON parse", err)
 	}
 
 	return results, nil
@@ -45,21 +57,31 @@ func (b *Bluecat) GetEntitiesByName(name string, parentid int, objecttype string
 // entities. The list begins at an index of 0.
 //
 // Returns an array of type APIEntity. The array is empty if there are no matching entities.
-func (b *Bluecat) GetEntities(parentid int, objecttype string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) GetEntities(parentid int, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	return b.GetEntitiesContext(context.Background(), parentid, objecttype, count, start)
+}
+
+// GetEntitiesContext performs GetEntities using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntitiesContext(ctx context.Context, parentid int, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetEntities request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntities?parentId=%d&type=%s&count=%d&start=%d",
-		b.Server, b.URI, parentid, objecttype, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"parentId": {strconv.Itoa(parentid)},
+		"type":     {string(objecttype)},
+		"count":    {strconv.Itoa(count)},
+		"start":    {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getEntities", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetEntities request", err)
+		return nil, fmt.Errorf("%w - GetEntities request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetEntities JSON parse", err)
+		return nil, fmt.Errorf("%w - GetEntities JSON parse", err)
 	}
 
 	return results, nil
@@ -72,21 +94,36 @@ func (b *Bluecat) GetEntities(parentid int, objecttype string, count, start int)
 // constants types constants.
 //
 // Returns the specified IPv4 block object from the database. Return type is APIEntity.
-func (b *Bluecat) GetEntityByCIDR(cidr string, parentid int, objecttype string) (APIEntity, error) {
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
+func (b *Bluecat) GetEntityByCIDR(cidr string, parentid int, objecttype ObjectType) (APIEntity, error) {
+	return b.GetEntityByCIDRContext(context.Background(), cidr, parentid, objecttype)
+}
+
+// GetEntityByCIDRContext performs GetEntityByCIDR using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntityByCIDRContext(ctx context.Context, cidr string, parentid int, objecttype ObjectType) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntityByCIDR?cidr=%s&parentId=%d&type=%s",
-		b.Server, b.URI, cidr, parentid, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	if err := objecttype.validate(); err != nil {
+		return results, fmt.Errorf("%w - GetEntityByCIDR request", err)
+	}
+
+	params := url.Values{
+		"cidr":     {cidr},
+		"parentId": {strconv.Itoa(parentid)},
+		"type":     {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getEntityByCIDR", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetEntityByCIDR request", err)
+		return results, fmt.Errorf("%w - GetEntityByCIDR request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetEntityByCIDR JSON parse", err)
+		return results, fmt.Errorf("%w - GetEntityByCIDR JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getEntityByCIDR")
 	}
 
 	return results, nil
@@ -99,21 +136,30 @@ func (b *Bluecat) GetEntityByCIDR(cidr string, parentid int, objecttype string)
 // Parameter `id` is the object ID of the target object.
 //
 // Returns the requested object from the database with its properties fields populated. Retury type is APIEntity.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetEntityByID(id int) (APIEntity, error) {
+	return b.GetEntityByIDContext(context.Background(), id)
+}
+
+// GetEntityByIDContext performs GetEntityByID using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntityByIDContext(ctx context.Context, id int) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntityById?id=%d",
-		b.Server, b.URI, id)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"id": {strconv.Itoa(id)},
+	}
+	resp, err := b.get(ctx, "getEntityById", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetEntityByID request", err)
+		return results, fmt.Errorf("%w - GetEntityByID request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetEntityByID JSON parse", err)
+		return results, fmt.Errorf("%w - GetEntityByID JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getEntityById")
 	}
 
 	return results, nil
@@ -124,22 +170,37 @@ func (b *Bluecat) GetEntityByID(id int) (APIEntity, error) {
 // Parameter `name` is the name of the entity. Parameter `parentid` is the ID of the target object’s parent object.
 // Parameter `objecttype` is the type of object returned by the method. This string must be one of the object type constants.
 //
-// Returns an array of entities. The array is empty if there are no matching entities. Return type is APIEntity.
-func (b *Bluecat) GetEntityByName(name string, parentid int, objecttype string) (APIEntity, error) {
+// Returns the matching entity. Return type is APIEntity.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
+func (b *Bluecat) GetEntityByName(name string, parentid int, objecttype ObjectType) (APIEntity, error) {
+	return b.GetEntityByNameContext(context.Background(), name, parentid, objecttype)
+}
+
+// GetEntityByNameContext performs GetEntityByName using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntityByNameContext(ctx context.Context, name string, parentid int, objecttype ObjectType) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntityByName?name=%s&parentId=%d&type=%s",
-		b.Server, b.URI, name, parentid, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	if err := objecttype.validate(); err != nil {
+		return results, fmt.Errorf("%w - GetEntityByName request", err)
+	}
+
+	params := url.Values{
+		"name":     {name},
+		"parentId": {strconv.Itoa(parentid)},
+		"type":     {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getEntityByName", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetEntityByName request", err)
+		return results, fmt.Errorf("%w - GetEntityByName request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetEntityByName JSON parse", err)
+		return results, fmt.Errorf("%w - GetEntityByName JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getEntityByName")
 	}
 
 	return results, nil
@@ -152,22 +213,37 @@ func (b *Bluecat) GetEntityByName(name string, parentid int, objecttype string)
 // This value cannot be empty. Parameter `objecttype` is the type of object to be returned. This string must be one of
 // the object type constants.
 //
-// Returns an APIEntity for the specified IPv6 block or network. The APIEntity is empty if the block or network does not exist.
-func (b *Bluecat) GetEntityByPrefix(containerid int, prefix, objecttype string) (APIEntity, error) {
+// Returns an APIEntity for the specified IPv6 block or network.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
+func (b *Bluecat) GetEntityByPrefix(containerid int, prefix string, objecttype ObjectType) (APIEntity, error) {
+	return b.GetEntityByPrefixContext(context.Background(), containerid, prefix, objecttype)
+}
+
+// GetEntityByPrefixContext performs GetEntityByPrefix using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntityByPrefixContext(ctx context.Context, containerid int, prefix string, objecttype ObjectType) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntityByPrefix?containerId=%d&prefix=%s&type=%s",
-		b.Server, b.URI, containerid, prefix, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	if err := objecttype.validate(); err != nil {
+		return results, fmt.Errorf("%w - GetEntityByPrefix request", err)
+	}
+
+	params := url.Values{
+		"containerId": {strconv.Itoa(containerid)},
+		"prefix":      {prefix},
+		"type":        {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getEntityByPrefix", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetEntityByPrefix request", err)
+		return results, fmt.Errorf("%w - GetEntityByPrefix request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetEntityByPrefix JSON parse", err)
+		return results, fmt.Errorf("%w - GetEntityByPrefix JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getEntityByPrefix")
 	}
 
 	return results, nil
@@ -180,21 +256,37 @@ func (b *Bluecat) GetEntityByPrefix(containerid int, prefix, objecttype string)
 // of the DHCP range. Parameter `objecttype` is the type of object returned: DHCP4Range. This must be one of the object type constants.
 //
 // Returns the requested IPv4 block object from the database. Return type is APIEntity.
-func (b *Bluecat) GetEntityByRange(address1, address2 string, parentid int, objecttype string) (APIEntity, error) {
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
+func (b *Bluecat) GetEntityByRange(address1, address2 string, parentid int, objecttype ObjectType) (APIEntity, error) {
+	return b.GetEntityByRangeContext(context.Background(), address1, address2, parentid, objecttype)
+}
+
+// GetEntityByRangeContext performs GetEntityByRange using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntityByRangeContext(ctx context.Context, address1, address2 string, parentid int, objecttype ObjectType) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntityByRange?address1=%s&address2=%s&parentId=%d&type=%s",
-		b.Server, b.URI, address1, address2, parentid, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	if err := objecttype.validate(); err != nil {
+		return results, fmt.Errorf("%w - GetEntityByRange request", err)
+	}
+
+	params := url.Values{
+		"address1": {address1},
+		"address2": {address2},
+		"parentId": {strconv.Itoa(parentid)},
+		"type":     {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getEntityByRange", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetEntityByRange request", err)
+		return results, fmt.Errorf("%w - GetEntityByRange request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetEntityByRange JSON parse", err)
+		return results, fmt.Errorf("%w - GetEntityByRange JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getEntityByRange")
 	}
 
 	return results, nil
@@ -214,21 +306,31 @@ func (b *Bluecat) GetEntityByRange(address1, address2 string, parentid int, obje
 //
 // Returns an array of type APIEntity matching the specified object properties or returns an empty array. The APIEntity will
 // at least contain Object Type, Object ID, Object Name, and Object Properties.
-func (b *Bluecat) CustomSearch(filters, objecttype string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) CustomSearch(filters string, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	return b.CustomSearchContext(context.Background(), filters, objecttype, count, start)
+}
+
+// CustomSearchContext performs CustomSearch using ctx to cancel or time out the request.
+func (b *Bluecat) CustomSearchContext(ctx context.Context, filters string, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - CustomSearch request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/customSearch?filters=%s&type=%s&count=%d&start=%d",
-		b.Server, b.URI, filters, objecttype, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"filters": {filters},
+		"type":    {string(objecttype)},
+		"count":   {strconv.Itoa(count)},
+		"start":   {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "customSearch", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - CustomSearch request", err)
+		return nil, fmt.Errorf("%w - CustomSearch request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - CustomSearch JSON parse", err)
+		return nil, fmt.Errorf("%w - CustomSearch JSON parse", err)
 	}
 
 	return results, nil
@@ -243,21 +345,31 @@ func (b *Bluecat) CustomSearch(filters, objecttype string, count, start int) ([]
 // of returned objects to start returning objects. The list begins at an index of 0. This value cannot be null or empty.
 //
 // Returns an array of type APIEntity matching the keyword text and the category type, or returns an empty array.
-func (b *Bluecat) SearchByCategory(keyword, category string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) SearchByCategory(keyword string, category SearchCategory, count, start int) ([]APIEntity, error) {
+	return b.SearchByCategoryContext(context.Background(), keyword, category, count, start)
+}
+
+// SearchByCategoryContext performs SearchByCategory using ctx to cancel or time out the request.
+func (b *Bluecat) SearchByCategoryContext(ctx context.Context, keyword string, category SearchCategory, count, start int) ([]APIEntity, error) {
+	if err := category.validate(); err != nil {
+		return nil, fmt.Errorf("%w - SearchByCategory request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/searchByCategory?keyword=%s&category=%s&count=%d&start=%d",
-		b.Server, b.URI, keyword, category, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"keyword":  {keyword},
+		"category": {string(category)},
+		"count":    {strconv.Itoa(count)},
+		"start":    {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "searchByCategory", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - SearchByCategory request", err)
+		return nil, fmt.Errorf("%w - SearchByCategory request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - SearchByCategory JSON parse", err)
+		return nil, fmt.Errorf("%w - SearchByCategory JSON parse", err)
 	}
 
 	return results, nil
@@ -267,27 +379,45 @@ func (b *Bluecat) SearchByCategory(keyword, category string, count, start int) (
 // specified object type. You can search for multiple object types with a single method call.
 //
 // Parameter `keyword` is the search keyword string. This value cannot be null or empty. Parameter `objecttypes`
-// is the object types for which to search, specified in the format: "type1[,type2...]". Parameter `count` is
+// is the object types for which to search, and must hold at least one object type. Parameter `count` is
 // the maximum number of objects to return. The default value is 10. This value cannot be null or empty.
 // Parameter `start` indicates where in the list of returned objects to start returning objects. The list begins
 // at an index of 0. This value cannot be null or empty.
 //
 // Returns an array of type APIEntity matching the keyword text and the category type, or returns an empty array.
-func (b *Bluecat) SearchByObjectTypes(keyword, objecttypes string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) SearchByObjectTypes(keyword string, objecttypes []ObjectType, count, start int) ([]APIEntity, error) {
+	return b.SearchByObjectTypesContext(context.Background(), keyword, objecttypes, count, start)
+}
+
+// SearchByObjectTypesContext performs SearchByObjectTypes using ctx to cancel or time out the request.
+func (b *Bluecat) SearchByObjectTypesContext(ctx context.Context, keyword string, objecttypes []ObjectType, count, start int) ([]APIEntity, error) {
+	if len(objecttypes) == 0 {
+		return nil, fmt.Errorf("%w - no object types - SearchByObjectTypes request", ErrInvalidArgument)
+	}
+
+	types := make([]string, len(objecttypes))
+	for i, t := range objecttypes {
+		if err := t.validate(); err != nil {
+			return nil, fmt.Errorf("%w - SearchByObjectTypes request", err)
+		}
+		types[i] = string(t)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/searchByObjectTypes?keyword=%s&types=%s&count=%d&start=%d",
-		b.Server, b.URI, keyword, objecttypes, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"keyword": {keyword},
+		"types":   {strings.Join(types, ",")},
+		"count":   {strconv.Itoa(count)},
+		"start":   {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "searchByObjectTypes", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - SearchByObjectTypes request", err)
+		return nil, fmt.Errorf("%w - SearchByObjectTypes request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - SearchByObjectTypes JSON parse", err)
+		return nil, fmt.Errorf("%w - SearchByObjectTypes JSON parse", err)
 	}
 
 	return results, nil
@@ -321,20 +451,26 @@ func (b *Bluecat) SearchByObjectTypes(keyword, objecttypes string, count, start
 // Returns an array of ResponsePolicySearchResult objects. Each object contains information of one Response Policy item
 // found either in local Response Policies or BlueCat Security feed data.
 func (b *Bluecat) SearchResponsePolicyItem(keyword, scope string, count, start int) ([]ResponsePolicySearchResult, error) {
+	return b.SearchResponsePolicyItemContext(context.Background(), keyword, scope, count, start)
+}
+
+// SearchResponsePolicyItemContext performs SearchResponsePolicyItem using ctx to cancel or time out the request.
+func (b *Bluecat) SearchResponsePolicyItemContext(ctx context.Context, keyword, scope string, count, start int) ([]ResponsePolicySearchResult, error) {
 	var results []ResponsePolicySearchResult
-	req := fmt.Sprintf("https://%s%s/searchResponsePolicyItem?keyword=%s&scope=%s&count=%d&start=%d",
-		b.Server, b.URI, keyword, scope, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"keyword": {keyword},
+		"scope":   {scope},
+		"count":   {strconv.Itoa(count)},
+		"start":   {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "searchResponsePolicyItem", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - SearchResponsePolicyItem request", err)
+		return nil, fmt.Errorf("%w - SearchResponsePolicyItem request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - SearchResponsePolicyItem JSON parse", err)
+		return nil, fmt.Errorf("%w - SearchResponsePolicyItem JSON parse", err)
 	}
 
 	return results, nil
@@ -350,20 +486,24 @@ func (b *Bluecat) SearchResponsePolicyItem(keyword, scope string, count, start i
 // Returns a list (array) of type APIEntity, of local response policies along with the associated response policy item under
 // a specific configuration or all configurations. This is determined by the input provided for the configurationId parameter.
 func (b *Bluecat) FindResponsePoliciesWithItem(configid int, itemname string) ([]APIEntity, error) {
+	return b.FindResponsePoliciesWithItemContext(context.Background(), configid, itemname)
+}
+
+// FindResponsePoliciesWithItemContext performs FindResponsePoliciesWithItem using ctx to cancel or time out the request.
+func (b *Bluecat) FindResponsePoliciesWithItemContext(ctx context.Context, configid int, itemname string) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/findResponsePoliciesWithItem?configurationId=%d&itemName=%s",
-		b.Server, b.URI, configid, itemname)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configid)},
+		"itemName":        {itemname},
+	}
+	resp, err := b.get(ctx, "findResponsePoliciesWithItem", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - FindResponsePoliciesWithItem request", err)
+		return nil, fmt.Errorf("%w - FindResponsePoliciesWithItem request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - FindResponsePoliciesWithItem JSON parse", err)
+		return nil, fmt.Errorf("%w - FindResponsePoliciesWithItem JSON parse", err)
 	}
 
 	return results, nil
@@ -375,21 +515,31 @@ func (b *Bluecat) FindResponsePoliciesWithItem(configid int, itemname string) ([
 // Parameter `userid` is the object ID of the user to whom the access right is applied.
 //
 // Returns the access right for the specified object. Return type is APIAccessRight.
+//
+// An error wrapping ErrNotFound is returned if the access right does not exist.
 func (b *Bluecat) GetAccessRight(entityid, userid int) (APIAccessRight, error) {
+	return b.GetAccessRightContext(context.Background(), entityid, userid)
+}
+
+// GetAccessRightContext performs GetAccessRight using ctx to cancel or time out the request.
+func (b *Bluecat) GetAccessRightContext(ctx context.Context, entityid, userid int) (APIAccessRight, error) {
 	var results APIAccessRight
-	req := fmt.Sprintf("https://%s%s/getAccessRight?entityId=%d&userId=%d",
-		b.Server, b.URI, entityid, userid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"userId":   {strconv.Itoa(userid)},
+	}
+	resp, err := b.get(ctx, "getAccessRight", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetAccessRight request", err)
+		return results, fmt.Errorf("%w - GetAccessRight request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetAccessRight JSON parse", err)
+		return results, fmt.Errorf("%w - GetAccessRight JSON parse", err)
+	}
+
+	if results.EntityID == 0 {
+		return results, notFound("getAccessRight")
 	}
 
 	return results, nil
@@ -404,20 +554,25 @@ func (b *Bluecat) GetAccessRight(entityid, userid int) (APIAccessRight, error) {
 //
 // Returns an array of type APIAccessRight objects.
 func (b *Bluecat) GetAccessRightsForEntity(entityid int, count, start int) ([]APIAccessRight, error) {
+	return b.GetAccessRightsForEntityContext(context.Background(), entityid, count, start)
+}
+
+// GetAccessRightsForEntityContext performs GetAccessRightsForEntity using ctx to cancel or time out the request.
+func (b *Bluecat) GetAccessRightsForEntityContext(ctx context.Context, entityid int, count, start int) ([]APIAccessRight, error) {
 	var results []APIAccessRight
-	req := fmt.Sprintf("https://%s%s/getAccessRightsForEntity?entityId=%d&count=%d&start=%d",
-		b.Server, b.URI, entityid, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"count":    {strconv.Itoa(count)},
+		"start":    {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getAccessRightsForEntity", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetAccessRightsForEntity request", err)
+		return nil, fmt.Errorf("%w - GetAccessRightsForEntity request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetAccessRightsForEntity JSON parse", err)
+		return nil, fmt.Errorf("%w - GetAccessRightsForEntity JSON parse", err)
 	}
 
 	return results, nil
@@ -432,20 +587,25 @@ func (b *Bluecat) GetAccessRightsForEntity(entityid int, count, start int) ([]AP
 //
 // Returns an array of type APIAccessRight objects.
 func (b *Bluecat) GetAccessRightsForUser(userid int, count, start int) ([]APIAccessRight, error) {
+	return b.GetAccessRightsForUserContext(context.Background(), userid, count, start)
+}
+
+// GetAccessRightsForUserContext performs GetAccessRightsForUser using ctx to cancel or time out the request.
+func (b *Bluecat) GetAccessRightsForUserContext(ctx context.Context, userid int, count, start int) ([]APIAccessRight, error) {
 	var results []APIAccessRight
-	req := fmt.Sprintf("https://%s%s/getAccessRightsForUser?userId=%d&count=%d&start=%d",
-		b.Server, b.URI, userid, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"userId": {strconv.Itoa(userid)},
+		"count":  {strconv.Itoa(count)},
+		"start":  {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getAccessRightsForUser", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetAccessRightsForUser request", err)
+		return nil, fmt.Errorf("%w - GetAccessRightsForUser request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetAccessRightsForUser JSON parse", err)
+		return nil, fmt.Errorf("%w - GetAccessRightsForUser JSON parse", err)
 	}
 
 	return results, nil
@@ -464,15 +624,19 @@ func (b *Bluecat) GetAccessRightsForUser(userid int, count, start int) ([]APIAcc
 // Returns the list of additional IP addresses configured on the server in the format: [IP,serviceType|IP,serviceType].
 // For example, 10.0.0.10/32,loopback|11.0.0.3/24,service|12.0.0.3/32,loopback. Return type is a string.
 func (b *Bluecat) GetAdditionalIPAddresses(adonisid int, properties string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getAdditionalIPAddresses?adonisId=%d&properties=%s",
-		b.Server, b.URI, adonisid, properties)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetAdditionalIPAddressesContext(context.Background(), adonisid, properties)
+}
+
+// GetAdditionalIPAddressesContext performs GetAdditionalIPAddresses using ctx to cancel or time out the request.
+func (b *Bluecat) GetAdditionalIPAddressesContext(ctx context.Context, adonisid int, properties string) (string, error) {
+	params := url.Values{
+		"adonisId":   {strconv.Itoa(adonisid)},
+		"properties": {properties},
+	}
+	resp, err := b.get(ctx, "getAdditionalIPAddresses", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetAdditionalIPAddresses request", err)
+		return "", fmt.Errorf("%w - GetAdditionalIPAddresses request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -506,20 +670,25 @@ func (b *Bluecat) GetAdditionalIPAddresses(adonisid int, properties string) (str
 //
 // Returns an array of Alias APIEntity objects.
 func (b *Bluecat) GetAliasesByHint(options string, count, start int) ([]APIEntity, error) {
+	return b.GetAliasesByHintContext(context.Background(), options, count, start)
+}
+
+// GetAliasesByHintContext performs GetAliasesByHint using ctx to cancel or time out the request.
+func (b *Bluecat) GetAliasesByHintContext(ctx context.Context, options string, count, start int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getAliasesByHint?options=%s&count=%d&start=%d",
-		b.Server, b.URI, options, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"options": {options},
+		"count":   {strconv.Itoa(count)},
+		"start":   {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getAliasesByHint", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetAliasesByHint request", err)
+		return nil, fmt.Errorf("%w - GetAliasesByHint request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetAliasesByHint JSON parse", err)
+		return nil, fmt.Errorf("%w - GetAliasesByHint JSON parse", err)
 	}
 
 	return results, nil
@@ -529,20 +698,20 @@ func (b *Bluecat) GetAliasesByHint(options string, count, start int) ([]APIEntit
 //
 // Returns an array of location APIEntity objects.
 func (b *Bluecat) GetAllUsedLocations() ([]APIEntity, error) {
+	return b.GetAllUsedLocationsContext(context.Background())
+}
+
+// GetAllUsedLocationsContext performs GetAllUsedLocations using ctx to cancel or time out the request.
+func (b *Bluecat) GetAllUsedLocationsContext(ctx context.Context) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getAllUsedLocations",
-		b.Server, b.URI)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	resp, err := b.get(ctx, "getAllUsedLocations", nil)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetAllUsedLocations request", err)
+		return nil, fmt.Errorf("%w - GetAllUsedLocations request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetAllUsedLocations JSON parse", err)
+		return nil, fmt.Errorf("%w - GetAllUsedLocations JSON parse", err)
 	}
 
 	return results, nil
@@ -552,15 +721,15 @@ func (b *Bluecat) GetAllUsedLocations() ([]APIEntity, error) {
 //
 // Returns a list of configuration groups. Return type is a string.
 func (b *Bluecat) GetConfigurationGroups() (string, error) {
-	req := fmt.Sprintf("https://%s%s/getConfigurationGroups",
-		b.Server, b.URI)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetConfigurationGroupsContext(context.Background())
+}
+
+// GetConfigurationGroupsContext performs GetConfigurationGroups using ctx to cancel or time out the request.
+func (b *Bluecat) GetConfigurationGroupsContext(ctx context.Context) (string, error) {
+	resp, err := b.get(ctx, "getConfigurationGroups", nil)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetConfigurationGroups request", err)
+		return "", fmt.Errorf("%w - GetConfigurationGroups request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -574,15 +743,19 @@ func (b *Bluecat) GetConfigurationGroups() (string, error) {
 //
 // Returns the properties of the setting of the configuration. Return type is a string.
 func (b *Bluecat) GetConfigurationSetting(configurationid int, setting string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getConfigurationSetting?configurationId=%d&settingName=%s",
-		b.Server, b.URI, configurationid, setting)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetConfigurationSettingContext(context.Background(), configurationid, setting)
+}
+
+// GetConfigurationSettingContext performs GetConfigurationSetting using ctx to cancel or time out the request.
+func (b *Bluecat) GetConfigurationSettingContext(ctx context.Context, configurationid int, setting string) (string, error) {
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configurationid)},
+		"settingName":     {setting},
+	}
+	resp, err := b.get(ctx, "getConfigurationSetting", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetConfigurationGroups request", err)
+		return "", fmt.Errorf("%w - GetConfigurationGroups request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -596,20 +769,42 @@ func (b *Bluecat) GetConfigurationSetting(configurationid int, setting string) (
 //
 // Returns a list/array of type APIEntity, of configurations based on the specified group.
 func (b *Bluecat) GetConfigurationsByGroup(group string) ([]APIEntity, error) {
+	return b.GetConfigurationsByGroupContext(context.Background(), group)
+}
+
+// GetConfigurationsByGroupContext performs GetConfigurationsByGroup using ctx to cancel or time out the request.
+func (b *Bluecat) GetConfigurationsByGroupContext(ctx context.Context, group string) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getConfigurationsByGroup?groupName=%s",
-		b.Server, b.URI, group)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"groupName": {group},
+	}
+	resp, err := b.get(ctx, "getConfigurationsByGroup", params)
 
 	if err != nil {
-		return nil, fmt.Errorf("%s - GetConfigurationsByGroup request", err)
+		return nil, fmt.Errorf("%w - GetConfigurationsByGroup request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return nil, fmt.Errorf("%s - GetConfigurationsByGroup JSON parse", err)
+		return nil, fmt.Errorf("%w - GetConfigurationsByGroup JSON parse", err)
+	}
+
+	return results, nil
+}
+
+// GetCustomOptionDefinitions returns all custom DHCP option definitions of a configuration.
+//
+// Parameter `configurationid` is the object ID of the configuration.
+//
+// Returns an array of type APIEntity. The array is empty if there are no custom DHCP option definitions.
+func (b *Bluecat) GetCustomOptionDefinitions(configurationid int) ([]APIEntity, error) {
+	return b.GetCustomOptionDefinitionsContext(context.Background(), configurationid)
+}
+
+// GetCustomOptionDefinitionsContext performs GetCustomOptionDefinitions using ctx to cancel or time out the request.
+func (b *Bluecat) GetCustomOptionDefinitionsContext(ctx context.Context, configurationid int) ([]APIEntity, error) {
+	results, err := b.allEntities(ctx, configurationid, ObjectTypeCustomOptionDef)
+	if err != nil {
+		return nil, fmt.Errorf("%w - GetCustomOptionDefinitions", err)
 	}
 
 	return results, nil
@@ -624,21 +819,32 @@ func (b *Bluecat) GetConfigurationsByGroup(group string) ([]APIEntity, error) {
 // to zero. Omitting this parameter from the method call will result in an error.
 //
 // Returns the specified DHCPv6 client option object from the database. Return type is APIDeploymentOption.
+//
+// An error wrapping ErrNotFound is returned if the deployment option does not exist.
 func (b *Bluecat) GetDHCP6ClientDeploymentOption(entityid int, name string, serverid int) (APIDeploymentOption, error) {
+	return b.GetDHCP6ClientDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// GetDHCP6ClientDeploymentOptionContext performs GetDHCP6ClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCP6ClientDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) (APIDeploymentOption, error) {
 	var results APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDHCP6ClientDeploymentOption?entityId=%d&name=%s&serverId=%d",
-		b.Server, b.URI, entityid, name, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"name":     {name},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDHCP6ClientDeploymentOption", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDHCP6ClientDeploymentOption request", err)
+		return results, fmt.Errorf("%w - GetDHCP6ClientDeploymentOption request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDHCP6ClientDeploymentOption JSON parse", err)
+		return results, fmt.Errorf("%w - GetDHCP6ClientDeploymentOption JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDHCP6ClientDeploymentOption")
 	}
 
 	return results, nil
@@ -654,21 +860,51 @@ func (b *Bluecat) GetDHCP6ClientDeploymentOption(entityid int, name string, serv
 // the method call will result in an error.
 //
 // Returns the requested DHCPv6 service option object from the database. Return type is APIDeploymentOption.
+//
+// An error wrapping ErrNotFound is returned if the deployment option does not exist.
 func (b *Bluecat) GetDHCP6ServiceDeploymentOption(entityid int, name string, serverid int) (APIDeploymentOption, error) {
+	return b.GetDHCP6ServiceDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// GetDHCP6ServiceDeploymentOptionContext performs GetDHCP6ServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCP6ServiceDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) (APIDeploymentOption, error) {
 	var results APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDHCP6ServiceDeploymentOption?entityId=%d&name=%s&serverId=%d",
-		b.Server, b.URI, entityid, name, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"name":     {name},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDHCP6ServiceDeploymentOption", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDHCP6ServiceDeploymentOption request", err)
+		return results, fmt.Errorf("%w - GetDHCP6ServiceDeploymentOption request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDHCP6ServiceDeploymentOption JSON parse", err)
+		return results, fmt.Errorf("%w - GetDHCP6ServiceDeploymentOption JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDHCP6ServiceDeploymentOption")
+	}
+
+	return results, nil
+}
+
+// GetDHCPClassOptions returns the DHCPv4 client options attached to a DHCP match class or sub-class.
+//
+// Parameter `classid` is the object ID of the DHCP match class or DHCP sub-class.
+//
+// Returns an array of type APIDeploymentOption, including options inherited from higher level parent objects.
+func (b *Bluecat) GetDHCPClassOptions(classid int) ([]APIDeploymentOption, error) {
+	return b.GetDHCPClassOptionsContext(context.Background(), classid)
+}
+
+// GetDHCPClassOptionsContext performs GetDHCPClassOptions using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPClassOptionsContext(ctx context.Context, classid int) ([]APIDeploymentOption, error) {
+	results, err := b.GetDeploymentOptionsContext(ctx, classid, []OptionType{OptionTypeDHCPV4ClientOption}, -1)
+	if err != nil {
+		return results, fmt.Errorf("%w - GetDHCPClassOptions", err)
 	}
 
 	return results, nil
@@ -684,21 +920,32 @@ func (b *Bluecat) GetDHCP6ServiceDeploymentOption(entityid int, name string, ser
 // result in an error.
 //
 // Returns the specified DHCPv4 client option object from the database. Return type is APIDeploymentOption.
+//
+// An error wrapping ErrNotFound is returned if the deployment option does not exist.
 func (b *Bluecat) GetDHCPClientDeploymentOption(entityid int, name string, serverid int) (APIDeploymentOption, error) {
+	return b.GetDHCPClientDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// GetDHCPClientDeploymentOptionContext performs GetDHCPClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPClientDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) (APIDeploymentOption, error) {
 	var results APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDHCPClientDeploymentOption?entityId=%d&name=%s&serverId=%d",
-		b.Server, b.URI, entityid, name, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"name":     {name},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDHCPClientDeploymentOption", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDHCPClientDeploymentOption request", err)
+		return results, fmt.Errorf("%w - GetDHCPClientDeploymentOption request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDHCPClientDeploymentOption JSON parse", err)
+		return results, fmt.Errorf("%w - GetDHCPClientDeploymentOption JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDHCPClientDeploymentOption")
 	}
 
 	return results, nil
@@ -709,23 +956,70 @@ func (b *Bluecat) GetDHCPClientDeploymentOption(entityid int, name string, serve
 // Parameter `entityid` is the object ID for the object to which the deployment role is assigned. Parameter `serverinterfaceid`
 // is the object ID of the server interface to which the role is assigned.
 //
-// Returns the DHCP deployment role assigned to the specified object, or returns an empty APIDeploymentRol if no role
-// is defined. Return type is APIDeploymentRole.
+// Returns the DHCP deployment role assigned to the specified object. Return type is APIDeploymentRole.
+//
+// An error wrapping ErrNotFound is returned if the deployment role does not exist.
 func (b *Bluecat) GetDHCPDeploymentRole(entityid, serverinterfaceid int) (APIDeploymentRole, error) {
+	return b.GetDHCPDeploymentRoleContext(context.Background(), entityid, serverinterfaceid)
+}
+
+// GetDHCPDeploymentRoleContext performs GetDHCPDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPDeploymentRoleContext(ctx context.Context, entityid, serverinterfaceid int) (APIDeploymentRole, error) {
 	var results APIDeploymentRole
-	req := fmt.Sprintf("https://%s%s/getDHCPDeploymentRole?entityId=%d&serverInterfaceId=%d",
-		b.Server, b.URI, entityid, serverinterfaceid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+	}
+	resp, err := b.get(ctx, "getDHCPDeploymentRole", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDHCPDeploymentRole request", err)
+		return results, fmt.Errorf("%w - GetDHCPDeploymentRole request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDHCPDeploymentRole JSON parse", err)
+		return results, fmt.Errorf("%w - GetDHCPDeploymentRole JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDHCPDeploymentRole")
+	}
+
+	return results, nil
+}
+
+// GetDHCPMatchClass returns the DHCP match class `name` in a configuration.
+//
+// Parameter `configurationid` is the object ID of the configuration that contains the match class.
+//
+// Returns the DHCP match class. Return type is APIEntity. ErrNotFound is returned if there is no such match class.
+func (b *Bluecat) GetDHCPMatchClass(configurationid int, name string) (APIEntity, error) {
+	return b.GetDHCPMatchClassContext(context.Background(), configurationid, name)
+}
+
+// GetDHCPMatchClassContext performs GetDHCPMatchClass using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPMatchClassContext(ctx context.Context, configurationid int, name string) (APIEntity, error) {
+	result, err := b.GetEntityByNameContext(ctx, name, configurationid, ObjectTypeDHCPMatchClass)
+	if err != nil {
+		return result, fmt.Errorf("%w - GetDHCPMatchClass", err)
+	}
+
+	return result, nil
+}
+
+// GetDHCPMatchClasses returns all DHCP match classes of a configuration.
+//
+// Parameter `configurationid` is the object ID of the configuration.
+//
+// Returns an array of type APIEntity. The array is empty if there are no match classes.
+func (b *Bluecat) GetDHCPMatchClasses(configurationid int) ([]APIEntity, error) {
+	return b.GetDHCPMatchClassesContext(context.Background(), configurationid)
+}
+
+// GetDHCPMatchClassesContext performs GetDHCPMatchClasses using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPMatchClassesContext(ctx context.Context, configurationid int) ([]APIEntity, error) {
+	results, err := b.allEntities(ctx, configurationid, ObjectTypeDHCPMatchClass)
+	if err != nil {
+		return nil, fmt.Errorf("%w - GetDHCPMatchClasses", err)
 	}
 
 	return results, nil
@@ -741,21 +1035,51 @@ func (b *Bluecat) GetDHCPDeploymentRole(entityid, serverinterfaceid int) (APIDep
 // the method call will result in an error.
 //
 // Returns the requested DHCPv4 service option object from the database. Return type is APIDeploymentOption.
+//
+// An error wrapping ErrNotFound is returned if the deployment option does not exist.
 func (b *Bluecat) GetDHCPServiceDeploymentOption(entityid int, name string, serverid int) (APIDeploymentOption, error) {
+	return b.GetDHCPServiceDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// GetDHCPServiceDeploymentOptionContext performs GetDHCPServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPServiceDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) (APIDeploymentOption, error) {
 	var results APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDHCPServiceDeploymentOption?entityId=%d&name=%s&serverId=%d",
-		b.Server, b.URI, entityid, name, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"name":     {name},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDHCPServiceDeploymentOption", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDHCPServiceDeploymentOption request", err)
+		return results, fmt.Errorf("%w - GetDHCPServiceDeploymentOption request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDHCPServiceDeploymentOption JSON parse", err)
+		return results, fmt.Errorf("%w - GetDHCPServiceDeploymentOption JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDHCPServiceDeploymentOption")
+	}
+
+	return results, nil
+}
+
+// GetDHCPSubClasses returns all DHCP sub-classes of a DHCP match class.
+//
+// Parameter `matchclassid` is the object ID of the DHCP match class.
+//
+// Returns an array of type APIEntity. The array is empty if there are no sub-classes.
+func (b *Bluecat) GetDHCPSubClasses(matchclassid int) ([]APIEntity, error) {
+	return b.GetDHCPSubClassesContext(context.Background(), matchclassid)
+}
+
+// GetDHCPSubClassesContext performs GetDHCPSubClasses using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPSubClassesContext(ctx context.Context, matchclassid int) ([]APIEntity, error) {
+	results, err := b.allEntities(ctx, matchclassid, ObjectTypeDHCPSubClass)
+	if err != nil {
+		return nil, fmt.Errorf("%w - GetDHCPSubClasses", err)
 	}
 
 	return results, nil
@@ -771,21 +1095,32 @@ func (b *Bluecat) GetDHCPServiceDeploymentOption(entityid int, name string, serv
 // assigned to a server, set this value to 0 (zero). Omitting this parameter from the method call will result in an error.
 //
 // Returns an APIDeploymentOption for the DHCP vendor client deployment option. Return type is APIDeploymentOption.
+//
+// An error wrapping ErrNotFound is returned if the deployment option does not exist.
 func (b *Bluecat) GetDHCPVendorDeploymentOption(entityid, optionid, serverid int) (APIDeploymentOption, error) {
+	return b.GetDHCPVendorDeploymentOptionContext(context.Background(), entityid, optionid, serverid)
+}
+
+// GetDHCPVendorDeploymentOptionContext performs GetDHCPVendorDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) GetDHCPVendorDeploymentOptionContext(ctx context.Context, entityid, optionid, serverid int) (APIDeploymentOption, error) {
 	var results APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDHCPVendorDeploymentOption?entityId=%d&optionId=%d&serverId=%d",
-		b.Server, b.URI, entityid, optionid, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"optionId": {strconv.Itoa(optionid)},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDHCPVendorDeploymentOption", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDHCPVendorDeploymentOption request", err)
+		return results, fmt.Errorf("%w - GetDHCPVendorDeploymentOption request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDHCPVendorDeploymentOption JSON parse", err)
+		return results, fmt.Errorf("%w - GetDHCPVendorDeploymentOption JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDHCPVendorDeploymentOption")
 	}
 
 	return results, nil
@@ -799,23 +1134,34 @@ func (b *Bluecat) GetDHCPVendorDeploymentOption(entityid, optionid, serverid int
 // the server or server group to which this option is assigned. To retrieve an option that has not been assigned to a
 // server role, set this value to 0 (zero). Omitting this parameter from the method call will result in an error.
 //
-// Returns an instance of the type APIDeploymentOption that represents the DNS deployment option or empty if none were found.
+// Returns an instance of the type APIDeploymentOption that represents the DNS deployment option.
 // Return type is APIDeploymentOption.
+//
+// An error wrapping ErrNotFound is returned if the deployment option does not exist.
 func (b *Bluecat) GetDNSDeploymentOption(entityid int, name string, serverid int) (APIDeploymentOption, error) {
+	return b.GetDNSDeploymentOptionContext(context.Background(), entityid, name, serverid)
+}
+
+// GetDNSDeploymentOptionContext performs GetDNSDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) GetDNSDeploymentOptionContext(ctx context.Context, entityid int, name string, serverid int) (APIDeploymentOption, error) {
 	var results APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDNSDeploymentOption?entityId=%d&name=%s&serverId=%d",
-		b.Server, b.URI, entityid, name, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"name":     {name},
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDNSDeploymentOption", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDNSDeploymentOption request", err)
+		return results, fmt.Errorf("%w - GetDNSDeploymentOption request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDNSDeploymentOption JSON parse", err)
+		return results, fmt.Errorf("%w - GetDNSDeploymentOption JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDNSDeploymentOption")
 	}
 
 	return results, nil
@@ -828,21 +1174,32 @@ func (b *Bluecat) GetDNSDeploymentOption(entityid int, name string, serverid int
 // view in which the DNS deployment role is assigned.
 //
 // Returns the requested APIDeploymentRole object. Return type is APIDeploymentRole.
+//
+// An error wrapping ErrNotFound is returned if the deployment role does not exist.
 func (b *Bluecat) GetDNSDeploymentRoleForView(entityid, serverinterfaceid, viewid int) (APIDeploymentRole, error) {
+	return b.GetDNSDeploymentRoleForViewContext(context.Background(), entityid, serverinterfaceid, viewid)
+}
+
+// GetDNSDeploymentRoleForViewContext performs GetDNSDeploymentRoleForView using ctx to cancel or time out the request.
+func (b *Bluecat) GetDNSDeploymentRoleForViewContext(ctx context.Context, entityid, serverinterfaceid, viewid int) (APIDeploymentRole, error) {
 	var results APIDeploymentRole
-	req := fmt.Sprintf("https://%s%s/getDNSDeploymentRoleForView?entityId=%d&serverInterfaceId=%d&viewId=%d",
-		b.Server, b.URI, entityid, serverinterfaceid, viewid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+		"viewId":            {strconv.Itoa(viewid)},
+	}
+	resp, err := b.get(ctx, "getDNSDeploymentRoleForView", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDNSDeploymentRoleForView request", err)
+		return results, fmt.Errorf("%w - GetDNSDeploymentRoleForView request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDNSDeploymentRoleForView JSON parse", err)
+		return results, fmt.Errorf("%w - GetDNSDeploymentRoleForView JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDNSDeploymentRoleForView")
 	}
 
 	return results, nil
@@ -853,23 +1210,33 @@ func (b *Bluecat) GetDNSDeploymentRoleForView(entityid, serverinterfaceid, viewi
 // Parameter `entityid` is the object ID for the object to which the DNS deployment role is assigned. Parameter
 // `serverinterfaceid` is the object ID of the server interface to which the DNS deployment role is assigned.
 //
-// Returns a DNS deployment role from the specified object, or returns an empty APIDeploymentRole if no role is defined.
+// Returns a DNS deployment role from the specified object.
 // Return type is APIDeploymentRole.
+//
+// An error wrapping ErrNotFound is returned if the deployment role does not exist.
 func (b *Bluecat) GetDNSDeploymentRole(entityid, serverinterfaceid int) (APIDeploymentRole, error) {
+	return b.GetDNSDeploymentRoleContext(context.Background(), entityid, serverinterfaceid)
+}
+
+// GetDNSDeploymentRoleContext performs GetDNSDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) GetDNSDeploymentRoleContext(ctx context.Context, entityid, serverinterfaceid int) (APIDeploymentRole, error) {
 	var results APIDeploymentRole
-	req := fmt.Sprintf("https://%s%s/getDNSDeploymentRole?entityId=%d&serverInterfaceId=%d",
-		b.Server, b.URI, entityid, serverinterfaceid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+	}
+	resp, err := b.get(ctx, "getDNSDeploymentRole", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDNSDeploymentRole request", err)
+		return results, fmt.Errorf("%w - GetDNSDeploymentRole request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDNSDeploymentRole JSON parse", err)
+		return results, fmt.Errorf("%w - GetDNSDeploymentRole JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDNSDeploymentRole")
 	}
 
 	return results, nil
@@ -878,8 +1245,7 @@ func (b *Bluecat) GetDNSDeploymentRole(entityid, serverinterfaceid int) (APIDepl
 // GetDeploymentOptions retrieves deployment options for Address Manager DNS and DHCP services.
 //
 // Parameter `entityid` is the object ID of the entity to which the DNS or DHCP deployment option is assigned. Parameter
-// `optiontypes` is the type of deployment options. Multiple options can be separated by a | (pipe) character.
-// This value must be one of the following items:
+// `optiontypes` holds the types of deployment options to return. Each type must be one of the following items:
 //
 // DNSOption
 //
@@ -907,7 +1273,7 @@ func (b *Bluecat) GetDNSDeploymentRole(entityid, serverinterfaceid int) (APIDepl
 // error message: " Invalid deployment option found ". For example, if the user passes DHCPv6ClientOption for IPv4 networks,
 // it will return this error message as DHCPv6 client options are not a valid for IPv4 networks.
 //
-// If specified as an empty string "", all deployment options for the specified entity will be returned. Depending on the type
+// If `optiontypes` is empty, all deployment options for the specified entity will be returned. Depending on the type
 // of DNS deployment option being retrieved, the format of the value might differ. For more information, refer to
 // Reference: Deployment option value formats.
 //
@@ -921,21 +1287,34 @@ func (b *Bluecat) GetDNSDeploymentRole(entityid, serverinterfaceid int) (APIDepl
 //
 // Returns all deployment options, array of type APIDeploymentOption, assigned to the specified object including inherited
 // options from higher level parent objects. If an option is inherited and overridden, then only the overriding option will be returned.
-func (b *Bluecat) GetDeploymentOptions(entityid int, optiontypes string, serverid int) ([]APIDeploymentOption, error) {
+func (b *Bluecat) GetDeploymentOptions(entityid int, optiontypes []OptionType, serverid int) ([]APIDeploymentOption, error) {
+	return b.GetDeploymentOptionsContext(context.Background(), entityid, optiontypes, serverid)
+}
+
+// GetDeploymentOptionsContext performs GetDeploymentOptions using ctx to cancel or time out the request.
+func (b *Bluecat) GetDeploymentOptionsContext(ctx context.Context, entityid int, optiontypes []OptionType, serverid int) ([]APIDeploymentOption, error) {
+	types := make([]string, len(optiontypes))
+	for i, t := range optiontypes {
+		if err := t.validate(); err != nil {
+			return nil, fmt.Errorf("%w - GetDeploymentOptions request", err)
+		}
+		types[i] = string(t)
+	}
+
 	var results []APIDeploymentOption
-	req := fmt.Sprintf("https://%s%s/getDeploymentOptions?entityId=%d&optionTypes=%s&serverId=%d",
-		b.Server, b.URI, entityid, optiontypes, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId":    {strconv.Itoa(entityid)},
+		"optionTypes": {strings.Join(types, "|")},
+		"serverId":    {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getDeploymentOptions", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDeploymentOptions request", err)
+		return results, fmt.Errorf("%w - GetDeploymentOptions request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDeploymentOptions JSON parse", err)
+		return results, fmt.Errorf("%w - GetDeploymentOptions JSON parse", err)
 	}
 
 	return results, nil
@@ -947,20 +1326,23 @@ func (b *Bluecat) GetDeploymentOptions(entityid int, optiontypes string, serveri
 //
 // Returns an array of APIDeploymentRole objects representing the deployment roles associated with the specified object.
 func (b *Bluecat) GetDeploymentRoles(entityid int) ([]APIDeploymentRole, error) {
+	return b.GetDeploymentRolesContext(context.Background(), entityid)
+}
+
+// GetDeploymentRolesContext performs GetDeploymentRoles using ctx to cancel or time out the request.
+func (b *Bluecat) GetDeploymentRolesContext(ctx context.Context, entityid int) ([]APIDeploymentRole, error) {
 	var results []APIDeploymentRole
-	req := fmt.Sprintf("https://%s%s/getDeploymentRoles?entityId=%d",
-		b.Server, b.URI, entityid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+	}
+	resp, err := b.get(ctx, "getDeploymentRoles", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDeploymentRoles request", err)
+		return results, fmt.Errorf("%w - GetDeploymentRoles request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDeploymentRoles JSON parse", err)
+		return results, fmt.Errorf("%w - GetDeploymentRoles JSON parse", err)
 	}
 
 	return results, nil
@@ -972,15 +1354,18 @@ func (b *Bluecat) GetDeploymentRoles(entityid int) ([]APIDeploymentRole, error)
 //
 // Returns a string value of the overall deployment status and the deployment status of individual entities. Return type is a string.
 func (b *Bluecat) GetDeploymentTaskStatus(deploymenttasktoken string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getDeploymentRoles?entityId=%s",
-		b.Server, b.URI, deploymenttasktoken)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetDeploymentTaskStatusContext(context.Background(), deploymenttasktoken)
+}
+
+// GetDeploymentTaskStatusContext performs GetDeploymentTaskStatus using ctx to cancel or time out the request.
+func (b *Bluecat) GetDeploymentTaskStatusContext(ctx context.Context, deploymenttasktoken string) (string, error) {
+	params := url.Values{
+		"deploymentTaskToken": {deploymenttasktoken},
+	}
+	resp, err := b.get(ctx, "getDeploymentTaskStatus", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetDeploymentTaskStatus request", err)
+		return "", fmt.Errorf("%w - GetDeploymentTaskStatus request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -993,20 +1378,24 @@ func (b *Bluecat) GetDeploymentTaskStatus(deploymenttasktoken string) (string, e
 //
 // Returns all ARP entries of a specific device. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDeviceArpEntries(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDeviceArpEntriesContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceArpEntriesContext performs GetDiscoveredDeviceArpEntries using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceArpEntriesContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDeviceArpEntries?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDeviceArpEntries", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceArpEntries request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceArpEntries request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceArpEntries JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceArpEntries JSON parse", err)
 	}
 
 	return results, nil
@@ -1019,20 +1408,24 @@ func (b *Bluecat) GetDiscoveredDeviceArpEntries(deviceid, policyid int) ([]APIEn
 //
 // Returns all hosts of a specific device. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDeviceHosts(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDeviceHostsContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceHostsContext performs GetDiscoveredDeviceHosts using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceHostsContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDeviceHosts?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDeviceHosts", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceHosts request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceHosts request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceHosts JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceHosts JSON parse", err)
 	}
 
 	return results, nil
@@ -1045,20 +1438,24 @@ func (b *Bluecat) GetDiscoveredDeviceHosts(deviceid, policyid int) ([]APIEntity,
 //
 // Returns all interfaces of a specific device. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDeviceInterfaces(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDeviceInterfacesContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceInterfacesContext performs GetDiscoveredDeviceInterfaces using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceInterfacesContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDeviceInterfaces?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDeviceInterfaces", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceInterfaces request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceInterfaces request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceInterfaces JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceInterfaces JSON parse", err)
 	}
 
 	return results, nil
@@ -1072,20 +1469,24 @@ func (b *Bluecat) GetDiscoveredDeviceInterfaces(deviceid, policyid int) ([]APIEn
 //
 // Returns all MAC address entries of a specific device. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDeviceMacAddressEntries(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDeviceMacAddressEntriesContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceMacAddressEntriesContext performs GetDiscoveredDeviceMacAddressEntries using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceMacAddressEntriesContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDeviceMacAddressEntries?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDeviceMacAddressEntries", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceMacAddressEntries request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceMacAddressEntries request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceMacAddressEntries JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceMacAddressEntries JSON parse", err)
 	}
 
 	return results, nil
@@ -1098,20 +1499,24 @@ func (b *Bluecat) GetDiscoveredDeviceMacAddressEntries(deviceid, policyid int) (
 //
 // Returns all networks of a specific device. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDeviceNetworks(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDeviceNetworksContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceNetworksContext performs GetDiscoveredDeviceNetworks using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceNetworksContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDeviceNetworks?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDeviceNetworks", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceNetworks request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceNetworks request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceNetworks JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceNetworks JSON parse", err)
 	}
 
 	return results, nil
@@ -1124,20 +1529,24 @@ func (b *Bluecat) GetDiscoveredDeviceNetworks(deviceid, policyid int) ([]APIEnti
 //
 // Returns all Vlans of a specific device. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDeviceVlans(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDeviceVlansContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceVlansContext performs GetDiscoveredDeviceVlans using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceVlansContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDeviceVlans?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDeviceVlans", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceVlans request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceVlans request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDeviceVlans JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDeviceVlans JSON parse", err)
 	}
 
 	return results, nil
@@ -1149,21 +1558,31 @@ func (b *Bluecat) GetDiscoveredDeviceVlans(deviceid, policyid int) ([]APIEntity,
 // reconciliation policy.
 //
 // Returns the object ID of the discovered device. Return type is APIEntity.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetDiscoveredDevice(deviceid, policyid int) (APIEntity, error) {
+	return b.GetDiscoveredDeviceContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDeviceContext performs GetDiscoveredDevice using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDeviceContext(ctx context.Context, deviceid, policyid int) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDevice?deviceId=%d&policyId=%d",
-		b.Server, b.URI, deviceid, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"deviceId": {strconv.Itoa(deviceid)},
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDevice", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDevice request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDevice request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDevice JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDevice JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getDiscoveredDevice")
 	}
 
 	return results, nil
@@ -1175,20 +1594,23 @@ func (b *Bluecat) GetDiscoveredDevice(deviceid, policyid int) (APIEntity, error)
 //
 // Returns an array of discovered Layer 2 or Layer 3 devices. Return type is an array of APIEntity.
 func (b *Bluecat) GetDiscoveredDevices(deviceid, policyid int) ([]APIEntity, error) {
+	return b.GetDiscoveredDevicesContext(context.Background(), deviceid, policyid)
+}
+
+// GetDiscoveredDevicesContext performs GetDiscoveredDevices using ctx to cancel or time out the request.
+func (b *Bluecat) GetDiscoveredDevicesContext(ctx context.Context, deviceid, policyid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getDiscoveredDevices?policyId=%d",
-		b.Server, b.URI, policyid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"policyId": {strconv.Itoa(policyid)},
+	}
+	resp, err := b.get(ctx, "getDiscoveredDevices", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDevices request", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDevices request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetDiscoveredDevices JSON parse", err)
+		return results, fmt.Errorf("%w - GetDiscoveredDevices JSON parse", err)
 	}
 
 	return results, nil
@@ -1210,26 +1632,98 @@ func (b *Bluecat) GetDiscoveredDevices(deviceid, policyid int) ([]APIEntity, err
 // value cannot be null or empty.
 //
 // Returns an array of type APIEntity. The array is empty if there are no matching entities.
-func (b *Bluecat) GetEntitiesByNameUsingOptions(name, options string, parentid int, objecttype string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) GetEntitiesByNameUsingOptions(name, options string, parentid int, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	return b.GetEntitiesByNameUsingOptionsContext(context.Background(), name, options, parentid, objecttype, count, start)
+}
+
+// GetEntitiesByNameUsingOptionsContext performs GetEntitiesByNameUsingOptions using ctx to cancel or time out the request.
+func (b *Bluecat) GetEntitiesByNameUsingOptionsContext(ctx context.Context, name, options string, parentid int, objecttype ObjectType, count, start int) ([]APIEntity, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetEntitiesByNameUsingOptions request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getEntitiesByNameUsingOptions?name=%s&options=%s&parentId=%d&type=%s&count=%d&start=%d",
-		b.Server, b.URI, name, options, parentid, objecttype, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"name":     {name},
+		"options":  {options},
+		"parentId": {strconv.Itoa(parentid)},
+		"type":     {string(objecttype)},
+		"count":    {strconv.Itoa(count)},
+		"start":    {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getEntitiesByNameUsingOptions", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetEntitiesByNameUsingOptions request", err)
+		return results, fmt.Errorf("%w - GetEntitiesByNameUsingOptions request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetEntitiesByNameUsingOptions JSON parse", err)
+		return results, fmt.Errorf("%w - GetEntitiesByNameUsingOptions JSON parse", err)
 	}
 
 	return results, nil
 }
 
+// GetEnumNumber returns the ENUM number `number` in an ENUM zone.
+//
+// Parameter `enumzoneid` is the object ID of the ENUM zone. Parameter `number` is the number, without the prefix of
+// the zone.
+//
+// Returns the ENUM number. Return type is APIEntity. ErrNotFound is returned if there is no such ENUM number.
+func (b *Bluecat) GetEnumNumber(enumzoneid int, number string) (APIEntity, error) {
+	return b.GetEnumNumberContext(context.Background(), enumzoneid, number)
+}
+
+// GetEnumNumberContext performs GetEnumNumber using ctx to cancel or time out the request.
+func (b *Bluecat) GetEnumNumberContext(ctx context.Context, enumzoneid int, number string) (APIEntity, error) {
+	result, err := b.GetEntityByNameContext(ctx, number, enumzoneid, ObjectTypeEnumNumber)
+	if err != nil {
+		return result, fmt.Errorf("%w - GetEnumNumber", err)
+	}
+
+	return result, nil
+}
+
+// GetEnumZone returns the ENUM zone with the prefix `prefix`.
+//
+// Parameter `parentid` is the object ID of the view or ENUM zone that contains the zone. Parameter `prefix` is the
+// telephone number prefix of the zone.
+//
+// Returns the ENUM zone. Return type is APIEntity. ErrNotFound is returned if there is no such ENUM zone.
+func (b *Bluecat) GetEnumZone(parentid int, prefix string) (APIEntity, error) {
+	return b.GetEnumZoneContext(context.Background(), parentid, prefix)
+}
+
+// GetEnumZoneContext performs GetEnumZone using ctx to cancel or time out the request.
+func (b *Bluecat) GetEnumZoneContext(ctx context.Context, parentid int, prefix string) (APIEntity, error) {
+	result, err := b.GetEntityByNameContext(ctx, prefix, parentid, ObjectTypeEnumZone)
+	if err != nil {
+		return result, fmt.Errorf("%w - GetEnumZone", err)
+	}
+
+	return result, nil
+}
+
+// GetExternalHostRecord returns the external host record `name` in a view.
+//
+// Parameter `viewid` is the object ID of the view that contains the record. Parameter `name` is the FQDN of the
+// external host.
+//
+// Returns the external host record. Return type is APIEntity. ErrNotFound is returned if there is no such external host record.
+func (b *Bluecat) GetExternalHostRecord(viewid int, name string) (APIEntity, error) {
+	return b.GetExternalHostRecordContext(context.Background(), viewid, name)
+}
+
+// GetExternalHostRecordContext performs GetExternalHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) GetExternalHostRecordContext(ctx context.Context, viewid int, name string) (APIEntity, error) {
+	result, err := b.GetEntityByNameContext(ctx, name, viewid, ObjectTypeExternalHostRecord)
+	if err != nil {
+		return result, fmt.Errorf("%w - GetExternalHostRecord", err)
+	}
+
+	return result, nil
+}
+
 // GetHostRecordsByHint returns an array of objects with host record type.
 //
 // Parameter `options` is a string containing options. The supported options are hint and retrieveFields. Separate multiple
@@ -1257,20 +1751,25 @@ func (b *Bluecat) GetEntitiesByNameUsingOptions(name, options string, parentid i
 //
 // Returns an array of host record APIEntity objects.
 func (b *Bluecat) GetHostRecordsByHint(options string, count, start int) ([]APIEntity, error) {
+	return b.GetHostRecordsByHintContext(context.Background(), options, count, start)
+}
+
+// GetHostRecordsByHintContext performs GetHostRecordsByHint using ctx to cancel or time out the request.
+func (b *Bluecat) GetHostRecordsByHintContext(ctx context.Context, options string, count, start int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getHostRecordsByHint?options=%s&count=%d&start=%d",
-		b.Server, b.URI, options, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"options": {options},
+		"count":   {strconv.Itoa(count)},
+		"start":   {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getHostRecordsByHint", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetHostRecordsByHint request", err)
+		return results, fmt.Errorf("%w - GetHostRecordsByHint request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetHostRecordsByHint JSON parse", err)
+		return results, fmt.Errorf("%w - GetHostRecordsByHint JSON parse", err)
 	}
 
 	return results, nil
@@ -1282,21 +1781,31 @@ func (b *Bluecat) GetHostRecordsByHint(options string, count, start int) ([]APIE
 // or DHCP range in which this address is located.
 //
 // Returns the requested IPv4 Address object from the database. Return type is APIEntity.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetIP4Address(address string, containerid int) (APIEntity, error) {
+	return b.GetIP4AddressContext(context.Background(), address, containerid)
+}
+
+// GetIP4AddressContext performs GetIP4Address using ctx to cancel or time out the request.
+func (b *Bluecat) GetIP4AddressContext(ctx context.Context, address string, containerid int) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getIP4Address?address=%s&containerId=%d",
-		b.Server, b.URI, address, containerid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"address":     {address},
+		"containerId": {strconv.Itoa(containerid)},
+	}
+	resp, err := b.get(ctx, "getIP4Address", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetIP4Address request", err)
+		return results, fmt.Errorf("%w - GetIP4Address request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetIP4Address JSON parse", err)
+		return results, fmt.Errorf("%w - GetIP4Address JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getIP4Address")
 	}
 
 	return results, nil
@@ -1335,20 +1844,26 @@ func (b *Bluecat) GetIP4Address(address string, containerid int) (APIEntity, err
 // Returns an array, type APIEntity, of IPv4 networks based on the input argument without their properties fields populated, or returns
 // an empty array if containerId is invalid. If no access right option is specified, the View access level will be used by default.
 func (b *Bluecat) GetIP4NetworksByHint(containerid int, options string, count, start int) ([]APIEntity, error) {
+	return b.GetIP4NetworksByHintContext(context.Background(), containerid, options, count, start)
+}
+
+// GetIP4NetworksByHintContext performs GetIP4NetworksByHint using ctx to cancel or time out the request.
+func (b *Bluecat) GetIP4NetworksByHintContext(ctx context.Context, containerid int, options string, count, start int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getIP4NetworksByHint?containerId=%d&options=%s&count=%d&start=%d",
-		b.Server, b.URI, containerid, options, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"containerId": {strconv.Itoa(containerid)},
+		"options":     {options},
+		"count":       {strconv.Itoa(count)},
+		"start":       {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getIP4NetworksByHint", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetIP4NetworksByHint request", err)
+		return results, fmt.Errorf("%w - GetIP4NetworksByHint request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetIP4NetworksByHint JSON parse", err)
+		return results, fmt.Errorf("%w - GetIP4NetworksByHint JSON parse", err)
 	}
 
 	return results, nil
@@ -1359,22 +1874,32 @@ func (b *Bluecat) GetIP4NetworksByHint(containerid int, options string, count, s
 // Parameter `address` is the IPv6 address. Parameter `containerid` is the object ID of the container in which the IPv6
 // address is located. The container can be a configuration, an IPv6 block, or an IPv6 network.
 //
-// Returns an APIEntity for the specified IPv6 address. The APIEntity is empty of the IPv6 address does not exist.
+// Returns an APIEntity for the specified IPv6 address.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetIP6Address(address string, containerid int) (APIEntity, error) {
+	return b.GetIP6AddressContext(context.Background(), address, containerid)
+}
+
+// GetIP6AddressContext performs GetIP6Address using ctx to cancel or time out the request.
+func (b *Bluecat) GetIP6AddressContext(ctx context.Context, address string, containerid int) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getIP6Address?address=%s&containerId=%d",
-		b.Server, b.URI, address, containerid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"address":     {address},
+		"containerId": {strconv.Itoa(containerid)},
+	}
+	resp, err := b.get(ctx, "getIP6Address", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetIP6Address request", err)
+		return results, fmt.Errorf("%w - GetIP6Address request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetIP6Address JSON parse", err)
+		return results, fmt.Errorf("%w - GetIP6Address JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getIP6Address")
 	}
 
 	return results, nil
@@ -1416,21 +1941,32 @@ func (b *Bluecat) GetIP6Address(address string, containerid int) (APIEntity, err
 //
 // Returns an array, type APIEntity, of IPv6 objects based on the input argument without their properties fields populated, or
 // returns an empty array if containerId is invalid. If no access right option is specified, the View access level will be used by default.
-func (b *Bluecat) GetIP6ObjectsByHint(containerid int, objecttype, options string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) GetIP6ObjectsByHint(containerid int, objecttype ObjectType, options string, count, start int) ([]APIEntity, error) {
+	return b.GetIP6ObjectsByHintContext(context.Background(), containerid, objecttype, options, count, start)
+}
+
+// GetIP6ObjectsByHintContext performs GetIP6ObjectsByHint using ctx to cancel or time out the request.
+func (b *Bluecat) GetIP6ObjectsByHintContext(ctx context.Context, containerid int, objecttype ObjectType, options string, count, start int) ([]APIEntity, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetIP6ObjectsByHint request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getIP6ObjectsByHint?containerId=%d&objectType=%s&options=%s&count=%d&start=%d",
-		b.Server, b.URI, containerid, objecttype, options, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"containerId": {strconv.Itoa(containerid)},
+		"objectType":  {string(objecttype)},
+		"options":     {options},
+		"count":       {strconv.Itoa(count)},
+		"start":       {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getIP6ObjectsByHint", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetIP6ObjectsByHint request", err)
+		return results, fmt.Errorf("%w - GetIP6ObjectsByHint request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetIP6ObjectsByHint JSON parse", err)
+		return results, fmt.Errorf("%w - GetIP6ObjectsByHint JSON parse", err)
 	}
 
 	return results, nil
@@ -1450,21 +1986,36 @@ func (b *Bluecat) GetIP6ObjectsByHint(containerid int, objecttype, options strin
 // for the IPv4 or IPv6 address.
 //
 // Returns an APIEntity for the object containing the specified address.
-func (b *Bluecat) GetIPRangeByIP(address string, containerid int, objecttype string) (APIEntity, error) {
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
+func (b *Bluecat) GetIPRangeByIP(address string, containerid int, objecttype ObjectType) (APIEntity, error) {
+	return b.GetIPRangeByIPContext(context.Background(), address, containerid, objecttype)
+}
+
+// GetIPRangeByIPContext performs GetIPRangeByIP using ctx to cancel or time out the request.
+func (b *Bluecat) GetIPRangeByIPContext(ctx context.Context, address string, containerid int, objecttype ObjectType) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getIPRangeByIP?address=%s&containerId=%d&type=%s",
-		b.Server, b.URI, address, containerid, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	if err := objecttype.validate(); err != nil {
+		return results, fmt.Errorf("%w - GetIPRangeByIP request", err)
+	}
+
+	params := url.Values{
+		"address":     {address},
+		"containerId": {strconv.Itoa(containerid)},
+		"type":        {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getIPRangeByIP", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetIPRangeByIP request", err)
+		return results, fmt.Errorf("%w - GetIPRangeByIP request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetIPRangeByIP JSON parse", err)
+		return results, fmt.Errorf("%w - GetIPRangeByIP JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getIPRangeByIP")
 	}
 
 	return results, nil
@@ -1480,15 +2031,19 @@ func (b *Bluecat) GetIPRangeByIP(address string, containerid int, objecttype str
 //
 // Returns a string containing up to two active KSK(s) of an entity.
 func (b *Bluecat) GetKSK(entityid int, format string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getKSK?entityId=%d&format=%s",
-		b.Server, b.URI, entityid, format)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetKSKContext(context.Background(), entityid, format)
+}
+
+// GetKSKContext performs GetKSK using ctx to cancel or time out the request.
+func (b *Bluecat) GetKSKContext(ctx context.Context, entityid int, format string) (string, error) {
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"format":   {format},
+	}
+	resp, err := b.get(ctx, "getKSK", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetKSK request", err)
+		return "", fmt.Errorf("%w - GetKSK request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1511,21 +2066,31 @@ func (b *Bluecat) GetKSK(entityid int, format string) (string, error) {
 // objects to start returning objects. The list begins at an index of 0. This value cannot be null or empty.
 //
 // Returns a string containing up to two active KSK(s) of an entity. Return type is an array of APIEntity.
-func (b *Bluecat) GetLinkedEntities(entityid int, linkedtype string, count, start int) ([]APIEntity, error) {
+func (b *Bluecat) GetLinkedEntities(entityid int, linkedtype ObjectType, count, start int) ([]APIEntity, error) {
+	return b.GetLinkedEntitiesContext(context.Background(), entityid, linkedtype, count, start)
+}
+
+// GetLinkedEntitiesContext performs GetLinkedEntities using ctx to cancel or time out the request.
+func (b *Bluecat) GetLinkedEntitiesContext(ctx context.Context, entityid int, linkedtype ObjectType, count, start int) ([]APIEntity, error) {
+	if err := linkedtype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetLinkedEntities request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getLinkedEntities?entityId=%d&type=%s&count=%d&start=%d",
-		b.Server, b.URI, entityid, linkedtype, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+		"type":     {string(linkedtype)},
+		"count":    {strconv.Itoa(count)},
+		"start":    {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getLinkedEntities", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetLinkedEntities request", err)
+		return results, fmt.Errorf("%w - GetLinkedEntities request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetLinkedEntities JSON parse", err)
+		return results, fmt.Errorf("%w - GetLinkedEntities JSON parse", err)
 	}
 
 	return results, nil
@@ -1547,22 +2112,31 @@ func (b *Bluecat) GetLinkedEntities(entityid int, linkedtype string, count, star
 // The code is case-sensitive. It must be all UPPER CASE letters. The county code and child location code should be
 // alphanumeric strings.
 //
-// Returns the APIEntity that matches the specified hierarchical location code. If no entity is found, returns an empty APIEntity.
+// Returns the APIEntity that matches the specified hierarchical location code.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetLocationByCode(code string) (APIEntity, error) {
+	return b.GetLocationByCodeContext(context.Background(), code)
+}
+
+// GetLocationByCodeContext performs GetLocationByCode using ctx to cancel or time out the request.
+func (b *Bluecat) GetLocationByCodeContext(ctx context.Context, code string) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getLocationByCode?code=%s",
-		b.Server, b.URI, code)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"code": {code},
+	}
+	resp, err := b.get(ctx, "getLocationByCode", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetLocationByCode request", err)
+		return results, fmt.Errorf("%w - GetLocationByCode request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetLocationByCode JSON parse", err)
+		return results, fmt.Errorf("%w - GetLocationByCode JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getLocationByCode")
 	}
 
 	return results, nil
@@ -1573,22 +2147,32 @@ func (b *Bluecat) GetLocationByCode(code string) (APIEntity, error) {
 // Parameter `configid` is the object ID of the configuration in which the MAC address is located. Parameter `macaddress`
 // is the MAC address in the format nnnnnnnnnnnn, nn-nn-nn-nn-nn-nn or nn:nn:nn:nn:nn:nn, where nn is a hexadecimal value.
 //
-// Returns an APIEntity for the MAC address. Returns an empty APIEntity if the MAC address does not exist.
+// Returns an APIEntity for the MAC address.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetMACAddress(configid int, macaddress string) (APIEntity, error) {
+	return b.GetMACAddressContext(context.Background(), configid, macaddress)
+}
+
+// GetMACAddressContext performs GetMACAddress using ctx to cancel or time out the request.
+func (b *Bluecat) GetMACAddressContext(ctx context.Context, configid int, macaddress string) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getMACAddress?configurationId=%d&macAddress=%s",
-		b.Server, b.URI, configid, macaddress)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configid)},
+		"macAddress":      {macaddress},
+	}
+	resp, err := b.get(ctx, "getMACAddress", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetMACAddress request", err)
+		return results, fmt.Errorf("%w - GetMACAddress request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetMACAddress JSON parse", err)
+		return results, fmt.Errorf("%w - GetMACAddress JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getMACAddress")
 	}
 
 	return results, nil
@@ -1601,15 +2185,18 @@ func (b *Bluecat) GetMACAddress(configid int, macaddress string) (APIEntity, err
 //
 // Returns the possible start address and end address for the specified IPv4 DHCP range object in the form of array of length 2.
 func (b *Bluecat) GetMaxAllowedRange(rangeid int) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getMaxAllowedRange?rangeId=%d",
-		b.Server, b.URI, rangeid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetMaxAllowedRangeContext(context.Background(), rangeid)
+}
+
+// GetMaxAllowedRangeContext performs GetMaxAllowedRange using ctx to cancel or time out the request.
+func (b *Bluecat) GetMaxAllowedRangeContext(ctx context.Context, rangeid int) (string, error) {
+	params := url.Values{
+		"rangeId": {strconv.Itoa(rangeid)},
+	}
+	resp, err := b.get(ctx, "getMaxAllowedRange", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetMaxAllowedRange request", err)
+		return "", fmt.Errorf("%w - GetMaxAllowedRange request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1622,20 +2209,23 @@ func (b *Bluecat) GetMaxAllowedRange(rangeid int) (string, error) {
 // Returns an array of IP address APIEntity objects with their linked host records and the IP addresses that are assigned
 // as DHCP Reserved, Static or Gateway. The output has the following format: hostId : hostName : zoneId : zoneName : viewId : viewName : hasAlias;.
 func (b *Bluecat) GetNetworkLinkedProperties(networkid int) ([]APIEntity, error) {
+	return b.GetNetworkLinkedPropertiesContext(context.Background(), networkid)
+}
+
+// GetNetworkLinkedPropertiesContext performs GetNetworkLinkedProperties using ctx to cancel or time out the request.
+func (b *Bluecat) GetNetworkLinkedPropertiesContext(ctx context.Context, networkid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getNetworkLinkedProperties?networkId=%d",
-		b.Server, b.URI, networkid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"networkId": {strconv.Itoa(networkid)},
+	}
+	resp, err := b.get(ctx, "getNetworkLinkedProperties", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetNetworkLinkedProperties request", err)
+		return results, fmt.Errorf("%w - GetNetworkLinkedProperties request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetNetworkLinkedProperties JSON parse", err)
+		return results, fmt.Errorf("%w - GetNetworkLinkedProperties JSON parse", err)
 	}
 
 	return results, nil
@@ -1648,15 +2238,18 @@ func (b *Bluecat) GetNetworkLinkedProperties(networkid int) ([]APIEntity, error)
 //
 // Returns the next available IPv4 address in an existing network as a string.
 func (b *Bluecat) GetNextAvailableIP4Address(parentid int) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getNextAvailableIP4Address?parentId=%d",
-		b.Server, b.URI, parentid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetNextAvailableIP4AddressContext(context.Background(), parentid)
+}
+
+// GetNextAvailableIP4AddressContext performs GetNextAvailableIP4Address using ctx to cancel or time out the request.
+func (b *Bluecat) GetNextAvailableIP4AddressContext(ctx context.Context, parentid int) (string, error) {
+	params := url.Values{
+		"parentId": {strconv.Itoa(parentid)},
+	}
+	resp, err := b.get(ctx, "getNextAvailableIP4Address", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetNextAvailableIP4Address request", err)
+		return "", fmt.Errorf("%w - GetNextAvailableIP4Address request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1675,15 +2268,21 @@ func (b *Bluecat) GetNextAvailableIP4Address(parentid int) (string, error) {
 // Returns the object ID for the existing next available IPv4 network or, if the next available network did not exist and
 // autoCreate was set to true, the newly created IPv4 network.
 func (b *Bluecat) GetNextAvailableIP4Network(autocreate, islargerallowed bool, parentid, size int) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getNextAvailableIP4Network?autoCreate=%t&isLargerAllowed=%t&parentId=%d&size=%d",
-		b.Server, b.URI, autocreate, islargerallowed, parentid, size)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetNextAvailableIP4NetworkContext(context.Background(), autocreate, islargerallowed, parentid, size)
+}
+
+// GetNextAvailableIP4NetworkContext performs GetNextAvailableIP4Network using ctx to cancel or time out the request.
+func (b *Bluecat) GetNextAvailableIP4NetworkContext(ctx context.Context, autocreate, islargerallowed bool, parentid, size int) (string, error) {
+	params := url.Values{
+		"autoCreate":      {strconv.FormatBool(autocreate)},
+		"isLargerAllowed": {strconv.FormatBool(islargerallowed)},
+		"parentId":        {strconv.Itoa(parentid)},
+		"size":            {strconv.Itoa(size)},
+	}
+	resp, err := b.get(ctx, "getNextAvailableIP4Network", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetNextAvailableIP4Network request", err)
+		return "", fmt.Errorf("%w - GetNextAvailableIP4Network request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1724,21 +2323,37 @@ func (b *Bluecat) GetNextAvailableIP4Network(autocreate, islargerallowed bool, p
 //
 // Returns the object ID, type APIEntity, for the existing next available IPv4 range or, if the next available IP range does not exist and
 // autoCreate was set to true, the newly created IPv4 range.
-func (b *Bluecat) GetNextAvailableIPRange(parentid int, properties string, size int, objecttype string) (APIEntity, error) {
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
+func (b *Bluecat) GetNextAvailableIPRange(parentid int, properties string, size int, objecttype ObjectType) (APIEntity, error) {
+	return b.GetNextAvailableIPRangeContext(context.Background(), parentid, properties, size, objecttype)
+}
+
+// GetNextAvailableIPRangeContext performs GetNextAvailableIPRange using ctx to cancel or time out the request.
+func (b *Bluecat) GetNextAvailableIPRangeContext(ctx context.Context, parentid int, properties string, size int, objecttype ObjectType) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getNextAvailableIPRange?parentId=%d&properties=%s&size=%d&type=%s",
-		b.Server, b.URI, parentid, properties, size, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	if err := objecttype.validate(); err != nil {
+		return results, fmt.Errorf("%w - GetNextAvailableIPRange request", err)
+	}
+
+	params := url.Values{
+		"parentId":   {strconv.Itoa(parentid)},
+		"properties": {properties},
+		"size":       {strconv.Itoa(size)},
+		"type":       {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getNextAvailableIPRange", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetNextAvailableIPRange request", err)
+		return results, fmt.Errorf("%w - GetNextAvailableIPRange request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetNextAvailableIPRange JSON parse", err)
+		return results, fmt.Errorf("%w - GetNextAvailableIPRange JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getNextAvailableIPRange")
 	}
 
 	return results, nil
@@ -1778,21 +2393,32 @@ func (b *Bluecat) GetNextAvailableIPRange(parentid int, properties string, size
 // Returns consecutive matching IPv4 range object IDs. If the next available ranges do not exist and you have set the
 // autoCreate property to true, new IPv4 ranges will be created and their object IDs will be returned. Return type is
 // an array of APIEntity.
-func (b *Bluecat) GetNextAvailableIPRanges(parentid int, properties string, size int, objecttype string, count int) ([]APIEntity, error) {
+func (b *Bluecat) GetNextAvailableIPRanges(parentid int, properties string, size int, objecttype ObjectType, count int) ([]APIEntity, error) {
+	return b.GetNextAvailableIPRangesContext(context.Background(), parentid, properties, size, objecttype, count)
+}
+
+// GetNextAvailableIPRangesContext performs GetNextAvailableIPRanges using ctx to cancel or time out the request.
+func (b *Bluecat) GetNextAvailableIPRangesContext(ctx context.Context, parentid int, properties string, size int, objecttype ObjectType, count int) ([]APIEntity, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetNextAvailableIPRanges request", err)
+	}
+
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getNextAvailableIPRanges?parentId=%d&properties=%s&size=%d&type=%s&count=%d",
-		b.Server, b.URI, parentid, properties, size, objecttype, count)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"parentId":   {strconv.Itoa(parentid)},
+		"properties": {properties},
+		"size":       {strconv.Itoa(size)},
+		"type":       {string(objecttype)},
+		"count":      {strconv.Itoa(count)},
+	}
+	resp, err := b.get(ctx, "getNextAvailableIPRanges", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetNextAvailableIPRanges request", err)
+		return results, fmt.Errorf("%w - GetNextAvailableIPRanges request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetNextAvailableIPRanges JSON parse", err)
+		return results, fmt.Errorf("%w - GetNextAvailableIPRanges JSON parse", err)
 	}
 
 	return results, nil
@@ -1818,15 +2444,19 @@ func (b *Bluecat) GetNextAvailableIPRanges(parentid int, properties string, size
 //
 // Returns the IPv4 address in octet notation. Return type is a string.
 func (b *Bluecat) GetNextIP4Address(parentid int, properties string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getNextIP4Address?parentId=%d&properties=%s",
-		b.Server, b.URI, parentid, properties)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetNextIP4AddressContext(context.Background(), parentid, properties)
+}
+
+// GetNextIP4AddressContext performs GetNextIP4Address using ctx to cancel or time out the request.
+func (b *Bluecat) GetNextIP4AddressContext(ctx context.Context, parentid int, properties string) (string, error) {
+	params := url.Values{
+		"parentId":   {strconv.Itoa(parentid)},
+		"properties": {properties},
+	}
+	resp, err := b.get(ctx, "getNextIP4Address", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetNextIP4Address request", err)
+		return "", fmt.Errorf("%w - GetNextIP4Address request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1838,21 +2468,30 @@ func (b *Bluecat) GetNextIP4Address(parentid int, properties string) (string, er
 // Parameter `entityid` is the entity ID of the parent object.
 //
 // Returns the APIEntity for the parent entity with its properties fields populated.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetParent(entityid int) (APIEntity, error) {
+	return b.GetParentContext(context.Background(), entityid)
+}
+
+// GetParentContext performs GetParent using ctx to cancel or time out the request.
+func (b *Bluecat) GetParentContext(ctx context.Context, entityid int) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getParent?entityId=%d",
-		b.Server, b.URI, entityid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"entityId": {strconv.Itoa(entityid)},
+	}
+	resp, err := b.get(ctx, "getParent", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetParent request", err)
+		return results, fmt.Errorf("%w - GetParent request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetParent JSON parse", err)
+		return results, fmt.Errorf("%w - GetParent JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getParent")
 	}
 
 	return results, nil
@@ -1865,20 +2504,23 @@ func (b *Bluecat) GetParent(entityid int) (APIEntity, error) {
 //
 // Returns the JSON response from the properties field of the APIData object. Return type is APIData.
 func (b *Bluecat) GetProbeData(definedprobe string) (APIData, error) {
+	return b.GetProbeDataContext(context.Background(), definedprobe)
+}
+
+// GetProbeDataContext performs GetProbeData using ctx to cancel or time out the request.
+func (b *Bluecat) GetProbeDataContext(ctx context.Context, definedprobe string) (APIData, error) {
 	var results APIData
-	req := fmt.Sprintf("https://%s%s/getProbeData?definedProbe=%s",
-		b.Server, b.URI, definedprobe)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"definedProbe": {definedprobe},
+	}
+	resp, err := b.get(ctx, "getProbeData", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetProbeData request", err)
+		return results, fmt.Errorf("%w - GetProbeData request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetProbeData JSON parse", err)
+		return results, fmt.Errorf("%w - GetProbeData JSON parse", err)
 	}
 
 	return results, nil
@@ -1891,15 +2533,18 @@ func (b *Bluecat) GetProbeData(definedprobe string) (APIData, error) {
 //
 // Returns a pre-defined value from 0 to 3, depending on the status of the data collection process. Return type is a string.
 func (b *Bluecat) GetProbeStatus(definedprobe string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getProbeStatus?definedProbe=%s",
-		b.Server, b.URI, definedprobe)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetProbeStatusContext(context.Background(), definedprobe)
+}
+
+// GetProbeStatusContext performs GetProbeStatus using ctx to cancel or time out the request.
+func (b *Bluecat) GetProbeStatusContext(ctx context.Context, definedprobe string) (string, error) {
+	params := url.Values{
+		"definedProbe": {definedprobe},
+	}
+	resp, err := b.get(ctx, "getProbeStatus", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetProbeStatus request", err)
+		return "", fmt.Errorf("%w - GetProbeStatus request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1911,15 +2556,15 @@ func (b *Bluecat) GetProbeStatus(definedprobe string) (string, error) {
 // Returns a JSON string containing the hostname, status of replication, latency, the IP address of the Primary and
 // standby servers, and cluster information.
 func (b *Bluecat) GetReplicationInfo() (string, error) {
-	req := fmt.Sprintf("https://%s%s/getReplicationInfo",
-		b.Server, b.URI)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetReplicationInfoContext(context.Background())
+}
+
+// GetReplicationInfoContext performs GetReplicationInfo using ctx to cancel or time out the request.
+func (b *Bluecat) GetReplicationInfoContext(ctx context.Context) (string, error) {
+	resp, err := b.get(ctx, "getReplicationInfo", nil)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetReplicationInfo request", err)
+		return "", fmt.Errorf("%w - GetReplicationInfo request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1932,20 +2577,23 @@ func (b *Bluecat) GetReplicationInfo() (string, error) {
 //
 // Returns a list of all deployment roles associated with the server. Return type is an array of APIDeploymentRole.
 func (b *Bluecat) GetServerDeploymentRoles(serverid int) ([]APIDeploymentRole, error) {
+	return b.GetServerDeploymentRolesContext(context.Background(), serverid)
+}
+
+// GetServerDeploymentRolesContext performs GetServerDeploymentRoles using ctx to cancel or time out the request.
+func (b *Bluecat) GetServerDeploymentRolesContext(ctx context.Context, serverid int) ([]APIDeploymentRole, error) {
 	var results []APIDeploymentRole
-	req := fmt.Sprintf("https://%s%s/getServerDeploymentRoles?serverId=%d",
-		b.Server, b.URI, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"serverId": {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getServerDeploymentRoles", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetServerDeploymentRoles request", err)
+		return results, fmt.Errorf("%w - GetServerDeploymentRoles request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetServerDeploymentRoles JSON parse", err)
+		return results, fmt.Errorf("%w - GetServerDeploymentRoles JSON parse", err)
 	}
 
 	return results, nil
@@ -1958,15 +2606,19 @@ func (b *Bluecat) GetServerDeploymentRoles(serverid int) ([]APIDeploymentRole, e
 //
 // Returns status code for deployment of a particular server. Return type is a string.
 func (b *Bluecat) GetServerDeploymentStatus(properties string, serverid int) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getServerDeploymentStatus?properties=%s&serverId=%d",
-		b.Server, b.URI, properties, serverid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetServerDeploymentStatusContext(context.Background(), properties, serverid)
+}
+
+// GetServerDeploymentStatusContext performs GetServerDeploymentStatus using ctx to cancel or time out the request.
+func (b *Bluecat) GetServerDeploymentStatusContext(ctx context.Context, properties string, serverid int) (string, error) {
+	params := url.Values{
+		"properties": {properties},
+		"serverId":   {strconv.Itoa(serverid)},
+	}
+	resp, err := b.get(ctx, "getServerDeploymentStatus", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetServerDeploymentStatus request", err)
+		return "", fmt.Errorf("%w - GetServerDeploymentStatus request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -1978,21 +2630,30 @@ func (b *Bluecat) GetServerDeploymentStatus(properties string, serverid int) (st
 // Parameter `roleid` is the object ID for the deployment role whose servers are to be returned.
 //
 // Returns an APIEntity object representing the servers associated with the specified deployment role.
+//
+// An error wrapping ErrNotFound is returned if the object does not exist.
 func (b *Bluecat) GetServerForRole(roleid int) (APIEntity, error) {
+	return b.GetServerForRoleContext(context.Background(), roleid)
+}
+
+// GetServerForRoleContext performs GetServerForRole using ctx to cancel or time out the request.
+func (b *Bluecat) GetServerForRoleContext(ctx context.Context, roleid int) (APIEntity, error) {
 	var results APIEntity
-	req := fmt.Sprintf("https://%s%s/getServerForRole?roleId=%d",
-		b.Server, b.URI, roleid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"roleId": {strconv.Itoa(roleid)},
+	}
+	resp, err := b.get(ctx, "getServerForRole", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetServerForRole request", err)
+		return results, fmt.Errorf("%w - GetServerForRole request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetServerForRole JSON parse", err)
+		return results, fmt.Errorf("%w - GetServerForRole JSON parse", err)
+	}
+
+	if results.ID == 0 {
+		return results, notFound("getServerForRole")
 	}
 
 	return results, nil
@@ -2006,20 +2667,23 @@ func (b *Bluecat) GetServerForRole(roleid int) (APIEntity, error) {
 // Returns an array of type APIEntity, of all the IPv4 networks linked to the given shared network tag. If no networks
 // are found, returns an empty array.
 func (b *Bluecat) GetSharedNetworks(tagid int) ([]APIEntity, error) {
+	return b.GetSharedNetworksContext(context.Background(), tagid)
+}
+
+// GetSharedNetworksContext performs GetSharedNetworks using ctx to cancel or time out the request.
+func (b *Bluecat) GetSharedNetworksContext(ctx context.Context, tagid int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getSharedNetworks?tagId=%d",
-		b.Server, b.URI, tagid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"tagId": {strconv.Itoa(tagid)},
+	}
+	resp, err := b.get(ctx, "getSharedNetworks", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetSharedNetworks request", err)
+		return results, fmt.Errorf("%w - GetSharedNetworks request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetSharedNetworks JSON parse", err)
+		return results, fmt.Errorf("%w - GetSharedNetworks JSON parse", err)
 	}
 
 	return results, nil
@@ -2029,15 +2693,15 @@ func (b *Bluecat) GetSharedNetworks(tagid int) ([]APIEntity, error) {
 //
 // Returns Address Manager system information. Return type is a string.
 func (b *Bluecat) GetSystemInfo() (string, error) {
-	req := fmt.Sprintf("https://%s%s/getSystemInfo",
-		b.Server, b.URI)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetSystemInfoContext(context.Background())
+}
+
+// GetSystemInfoContext performs GetSystemInfo using ctx to cancel or time out the request.
+func (b *Bluecat) GetSystemInfoContext(ctx context.Context) (string, error) {
+	resp, err := b.get(ctx, "getSystemInfo", nil)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetSystemInfo request", err)
+		return "", fmt.Errorf("%w - GetSystemInfo request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -2050,15 +2714,18 @@ func (b *Bluecat) GetSystemInfo() (string, error) {
 //
 // Returns a JSON string that contains the template status.
 func (b *Bluecat) GetTemplateTaskStatus(taskid int) (string, error) {
-	req := fmt.Sprintf("https://%s%s/getTemplateTaskStatus?taskId=%d",
-		b.Server, b.URI, taskid)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.GetTemplateTaskStatusContext(context.Background(), taskid)
+}
+
+// GetTemplateTaskStatusContext performs GetTemplateTaskStatus using ctx to cancel or time out the request.
+func (b *Bluecat) GetTemplateTaskStatusContext(ctx context.Context, taskid int) (string, error) {
+	params := url.Values{
+		"taskId": {strconv.Itoa(taskid)},
+	}
+	resp, err := b.get(ctx, "getTemplateTaskStatus", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - GetTemplateTaskStatus request", err)
+		return "", fmt.Errorf("%w - GetTemplateTaskStatus request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -2072,21 +2739,65 @@ func (b *Bluecat) GetTemplateTaskStatus(taskid int) (string, error) {
 // field belongs to. This must be one of the constants listed in Object types.
 //
 // Returns the user-defined fields information. Return type is an array of APIUserDefinedField.
-func (b *Bluecat) GetUserDefinedFields(requiredfieldsonly bool, objecttype string) ([]APIUserDefinedField, error) {
+func (b *Bluecat) GetUserDefinedFields(requiredfieldsonly bool, objecttype ObjectType) ([]APIUserDefinedField, error) {
+	return b.GetUserDefinedFieldsContext(context.Background(), requiredfieldsonly, objecttype)
+}
+
+// GetUserDefinedFieldsContext performs GetUserDefinedFields using ctx to cancel or time out the request.
+func (b *Bluecat) GetUserDefinedFieldsContext(ctx context.Context, requiredfieldsonly bool, objecttype ObjectType) ([]APIUserDefinedField, error) {
+	if err := objecttype.validate(); err != nil {
+		return nil, fmt.Errorf("%w - GetUserDefinedFields request", err)
+	}
+
 	var results []APIUserDefinedField
-	req := fmt.Sprintf("https://%s%s/getUserDefinedFields?requiredFieldsOnly=%t&type=%s",
-		b.Server, b.URI, requiredfieldsonly, objecttype)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"requiredFieldsOnly": {strconv.FormatBool(requiredfieldsonly)},
+		"type":               {string(objecttype)},
+	}
+	resp, err := b.get(ctx, "getUserDefinedFields", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetUserDefinedFields request", err)
+		return results, fmt.Errorf("%w - GetUserDefinedFields request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetUserDefinedFields JSON parse", err)
+		return results, fmt.Errorf("%w - GetUserDefinedFields JSON parse", err)
+	}
+
+	return results, nil
+}
+
+// GetVendorOptionDefinitions returns all option definitions of a vendor profile.
+//
+// Parameter `vendorprofileid` is the object ID of the vendor profile.
+//
+// Returns an array of type APIEntity. The array is empty if there are no option definitions.
+func (b *Bluecat) GetVendorOptionDefinitions(vendorprofileid int) ([]APIEntity, error) {
+	return b.GetVendorOptionDefinitionsContext(context.Background(), vendorprofileid)
+}
+
+// GetVendorOptionDefinitionsContext performs GetVendorOptionDefinitions using ctx to cancel or time out the request.
+func (b *Bluecat) GetVendorOptionDefinitionsContext(ctx context.Context, vendorprofileid int) ([]APIEntity, error) {
+	results, err := b.allEntities(ctx, vendorprofileid, ObjectTypeVendorOptionDef)
+	if err != nil {
+		return nil, fmt.Errorf("%w - GetVendorOptionDefinitions", err)
+	}
+
+	return results, nil
+}
+
+// GetVendorProfiles returns all vendor profiles. Vendor profiles are global and do not belong to a configuration.
+//
+// Returns an array of type APIEntity. The array is empty if there are no vendor profiles.
+func (b *Bluecat) GetVendorProfiles() ([]APIEntity, error) {
+	return b.GetVendorProfilesContext(context.Background())
+}
+
+// GetVendorProfilesContext performs GetVendorProfiles using ctx to cancel or time out the request.
+func (b *Bluecat) GetVendorProfilesContext(ctx context.Context) ([]APIEntity, error) {
+	results, err := b.allEntities(ctx, 0, ObjectTypeVendorProfile)
+	if err != nil {
+		return nil, fmt.Errorf("%w - GetVendorProfiles", err)
 	}
 
 	return results, nil
@@ -2119,20 +2830,26 @@ func (b *Bluecat) GetUserDefinedFields(requiredfieldsonly bool, objecttype strin
 // Returns an array, of type APIEntity, of zones based on the input argument without their properties fields populated, or returns
 // an empty array if containerId is invalid. If no access right option is specified, the View access level will be used by default.
 func (b *Bluecat) GetZonesByHint(containerid int, options string, count, start int) ([]APIEntity, error) {
+	return b.GetZonesByHintContext(context.Background(), containerid, options, count, start)
+}
+
+// GetZonesByHintContext performs GetZonesByHint using ctx to cancel or time out the request.
+func (b *Bluecat) GetZonesByHintContext(ctx context.Context, containerid int, options string, count, start int) ([]APIEntity, error) {
 	var results []APIEntity
-	req := fmt.Sprintf("https://%s%s/getZonesByHint?containerId=%d&options=%s&count=%d&start=%d",
-		b.Server, b.URI, containerid, options, count, start)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	params := url.Values{
+		"containerId": {strconv.Itoa(containerid)},
+		"options":     {options},
+		"count":       {strconv.Itoa(count)},
+		"start":       {strconv.Itoa(start)},
+	}
+	resp, err := b.get(ctx, "getZonesByHint", params)
 
 	if err != nil {
-		return results, fmt.Errorf("%s - GetZonesByHint request", err)
+		return results, fmt.Errorf("%w - GetZonesByHint request", err)
 	}
 
 	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
-		return results, fmt.Errorf("%s - GetZonesByHint JSON parse", err)
+		return results, fmt.Errorf("%w - GetZonesByHint JSON parse", err)
 	}
 
 	return results, nil
@@ -2146,15 +2863,20 @@ func (b *Bluecat) GetZonesByHint(containerid int, options string, count, start i
 //
 // Returns a Boolean value indicating whether the address is allocated.
 func (b *Bluecat) IsAddressAllocated(configid int, ipaddress, macaddress string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/isAddressAllocated?configurationId=%d&ipAddress=%s&macAddress=%s",
-		b.Server, b.URI, configid, ipaddress, macaddress)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.IsAddressAllocatedContext(context.Background(), configid, ipaddress, macaddress)
+}
+
+// IsAddressAllocatedContext performs IsAddressAllocated using ctx to cancel or time out the request.
+func (b *Bluecat) IsAddressAllocatedContext(ctx context.Context, configid int, ipaddress, macaddress string) (string, error) {
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configid)},
+		"ipAddress":       {ipaddress},
+		"macAddress":      {macaddress},
+	}
+	resp, err := b.get(ctx, "isAddressAllocated", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - IsAddressAllocated request", err)
+		return "", fmt.Errorf("%w - IsAddressAllocated request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -2171,15 +2893,18 @@ func (b *Bluecat) IsAddressAllocated(configid int, ipaddress, macaddress string)
 // Returns a Boolean value indicating if the specified file is currently migrating. When an empty string is specified
 // for the filename, returns a true if there are any migration files queued for migration or currently migrating.
 func (b *Bluecat) IsMigrationRunning(filename string) (string, error) {
-	req := fmt.Sprintf("https://%s%s/isMigrationRunning?filename=%s",
-		b.Server, b.URI, filename)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.IsMigrationRunningContext(context.Background(), filename)
+}
+
+// IsMigrationRunningContext performs IsMigrationRunning using ctx to cancel or time out the request.
+func (b *Bluecat) IsMigrationRunningContext(ctx context.Context, filename string) (string, error) {
+	params := url.Values{
+		"filename": {filename},
+	}
+	resp, err := b.get(ctx, "isMigrationRunning", params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - IsMigrationRunning request", err)
+		return "", fmt.Errorf("%w - IsMigrationRunning request", err)
 	}
 
 	formatted := strings.TrimLeft(strings.TrimRight(resp.String(), "\""), "\"")
@@ -2192,15 +2917,20 @@ func (b *Bluecat) IsMigrationRunning(filename string) (string, error) {
 // the object ID of the second entity in the pair of linked entities. Parameter `properties` Adds object properties,
 // including user-defined fields.
 func (b *Bluecat) LinkEntities(entity1id, entity2id int, properties string) error {
-	req := fmt.Sprintf("https://%s%s/linkEntities?entity1Id=%d&entity2Id=%d&properties=%s",
-		b.Server, b.URI, entity1id, entity2id, properties)
-	_, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Get(req)
+	return b.LinkEntitiesContext(context.Background(), entity1id, entity2id, properties)
+}
+
+// LinkEntitiesContext performs LinkEntities using ctx to cancel or time out the request.
+func (b *Bluecat) LinkEntitiesContext(ctx context.Context, entity1id, entity2id int, properties string) error {
+	params := url.Values{
+		"entity1Id":  {strconv.Itoa(entity1id)},
+		"entity2Id":  {strconv.Itoa(entity2id)},
+		"properties": {properties},
+	}
+	_, err := b.get(ctx, "linkEntities", params)
 
 	if err != nil {
-		return fmt.Errorf("%s - LinkEntities request", err)
+		return fmt.Errorf("%w - LinkEntities request", err)
 	}
 
 	return nil
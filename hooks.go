@@ -0,0 +1,327 @@
+package bluecat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestInfo describes a request that is about to be sent to the Address Manager. Secrets are redacted: the
+// password of a login request is replaced and the authentication token is never included.
+type RequestInfo struct {
+	// Method is the name of the API method, e.g. getEntityById.
+	Method string
+
+	// HTTPMethod is the HTTP method of the request, e.g. GET.
+	HTTPMethod string
+
+	// URL is the full request URL with the password redacted.
+	URL string
+
+	// Attempt is the number of the attempt, starting at 1. It is greater than 1 when a request is retried.
+	Attempt int
+}
+
+// ResponseInfo describes the outcome of a request sent to the Address Manager.
+type ResponseInfo struct {
+	Request *RequestInfo
+
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+
+	// Duration is the time it took to get the response.
+	Duration time.Duration
+
+	// Err is the transport error of the request, if any, with the password of a login request redacted. Error
+	// responses from the server are reported through StatusCode.
+	Err error
+}
+
+// Failed reports whether the request failed, either because no response was received or because the server
+// answered with an error status.
+func (r *ResponseInfo) Failed() bool {
+	return r.Err != nil || r.StatusCode < 200 || r.StatusCode >= 300
+}
+
+// Hook observes every request sent by a session. BeforeRequest is called before the request is sent and may
+// return a derived context, e.g. one carrying a tracing span, which is used for the request and passed on to
+// AfterResponse. Hooks are registered with the WithHooks option.
+type Hook interface {
+	BeforeRequest(ctx context.Context, req *RequestInfo) context.Context
+	AfterResponse(ctx context.Context, resp *ResponseInfo)
+}
+
+// HookFuncs adapts a pair of functions to the Hook interface. Either function may be nil.
+type HookFuncs struct {
+	Before func(ctx context.Context, req *RequestInfo) context.Context
+	After  func(ctx context.Context, resp *ResponseInfo)
+}
+
+// BeforeRequest implements Hook.
+func (h HookFuncs) BeforeRequest(ctx context.Context, req *RequestInfo) context.Context {
+	if h.Before == nil {
+		return ctx
+	}
+
+	return h.Before(ctx, req)
+}
+
+// AfterResponse implements Hook.
+func (h HookFuncs) AfterResponse(ctx context.Context, resp *ResponseInfo) {
+	if h.After != nil {
+		h.After(ctx, resp)
+	}
+}
+
+// WithHooks registers hooks that observe every request sent by the session, including logins. BeforeRequest is
+// called in the order the hooks are given, AfterResponse in the reverse order.
+func WithHooks(hooks ...Hook) Option {
+	return func(o *options) error {
+		o.hooks = append(o.hooks, hooks...)
+
+		return nil
+	}
+}
+
+// redactURL returns req with the value of the password query parameter replaced. If req cannot be parsed, its whole
+// query string is replaced.
+func redactURL(req string) string {
+	u, err := url.Parse(req)
+	if err != nil {
+		if i := strings.Index(req, "?"); i >= 0 {
+			return req[:i] + "?REDACTED"
+		}
+
+		return req
+	}
+
+	q := u.Query()
+	if _, ok := q["password"]; !ok {
+		return req
+	}
+	q.Set("password", "REDACTED")
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// redactError returns err, the error of a request sent with params, without the password of params. The URL of a
+// *url.Error is redacted with redactURL. Should the message of the error still contain the password, as given or
+// URL encoded, the error is replaced by a redactedError.
+func redactError(err error, params url.Values) error {
+	if err == nil {
+		return nil
+	}
+
+	if uerr, ok := err.(*url.Error); ok {
+		err = &url.Error{Op: uerr.Op, URL: redactURL(uerr.URL), Err: uerr.Err}
+	}
+
+	pass := params.Get("password")
+	if pass == "" {
+		return err
+	}
+
+	msg := err.Error()
+	redacted := strings.NewReplacer(pass, "REDACTED", url.QueryEscape(pass), "REDACTED").Replace(msg)
+	if redacted == msg {
+		return err
+	}
+
+	return &redactedError{msg: redacted, err: err}
+}
+
+// redactedError is an error whose message had a password removed. It does not unwrap to the original error, whose
+// message still holds the password, but errors.Is still matches it, e.g. against context.Canceled.
+type redactedError struct {
+	msg string
+	err error
+}
+
+// Error implements error.
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+// Is reports whether the original error matches target.
+func (e *redactedError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+// Logger writes a structured log entry made of a message and alternating keys and values. It can be adapted to
+// most logging libraries in a single line.
+type Logger func(msg string, keyvals ...interface{})
+
+// LoggingHook returns a Hook that logs every request and response to log.
+func LoggingHook(log Logger) Hook {
+	return HookFuncs{
+		Before: func(ctx context.Context, req *RequestInfo) context.Context {
+			log("bluecat request",
+				"method", req.Method,
+				"http_method", req.HTTPMethod,
+				"url", req.URL,
+				"attempt", req.Attempt)
+
+			return ctx
+		},
+		After: func(ctx context.Context, resp *ResponseInfo) {
+			keyvals := []interface{}{
+				"method", resp.Request.Method,
+				"status", resp.StatusCode,
+				"duration", resp.Duration,
+				"attempt", resp.Request.Attempt,
+			}
+			if resp.Err != nil {
+				keyvals = append(keyvals, "error", resp.Err.Error())
+			}
+
+			log("bluecat response", keyvals...)
+		},
+	}
+}
+
+// MethodStats holds the counters recorded by Metrics for a single API method.
+type MethodStats struct {
+	Requests int64
+	Errors   int64
+	Duration time.Duration
+}
+
+// Metrics is a Hook that counts requests, failed requests and the total latency per API method. The counters can
+// be read with Snapshot or exported in the Prometheus text format with WritePrometheus. The zero value is ready to use.
+type Metrics struct {
+	mu    sync.Mutex
+	stats map[string]*MethodStats
+}
+
+// BeforeRequest implements Hook.
+func (m *Metrics) BeforeRequest(ctx context.Context, req *RequestInfo) context.Context {
+	return ctx
+}
+
+// AfterResponse implements Hook.
+func (m *Metrics) AfterResponse(ctx context.Context, resp *ResponseInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stats == nil {
+		m.stats = make(map[string]*MethodStats)
+	}
+
+	s, ok := m.stats[resp.Request.Method]
+	if !ok {
+		s = &MethodStats{}
+		m.stats[resp.Request.Method] = s
+	}
+
+	s.Requests++
+	s.Duration += resp.Duration
+	if resp.Failed() {
+		s.Errors++
+	}
+}
+
+// Snapshot returns a copy of the counters, keyed by API method name.
+func (m *Metrics) Snapshot() map[string]MethodStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snap := make(map[string]MethodStats, len(m.stats))
+	for method, s := range m.stats {
+		snap[method] = *s
+	}
+
+	return snap
+}
+
+// WritePrometheus writes the counters to w in the Prometheus text exposition format, as the metrics
+// bluecat_requests_total, bluecat_request_errors_total and bluecat_request_duration_seconds_total.
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	snap := m.Snapshot()
+
+	methods := make([]string, 0, len(snap))
+	for method := range snap {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	metrics := []struct {
+		name, help string
+		value      func(MethodStats) string
+	}{
+		{"bluecat_requests_total", "Requests sent to the Address Manager.", func(s MethodStats) string {
+			return fmt.Sprintf("%d", s.Requests)
+		}},
+		{"bluecat_request_errors_total", "Requests that failed or returned an error status.", func(s MethodStats) string {
+			return fmt.Sprintf("%d", s.Errors)
+		}},
+		{"bluecat_request_duration_seconds_total", "Total time spent waiting for responses.", func(s MethodStats) string {
+			return fmt.Sprintf("%g", s.Duration.Seconds())
+		}},
+	}
+
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", metric.name, metric.help, metric.name); err != nil {
+			return err
+		}
+
+		for _, method := range methods {
+			if _, err := fmt.Fprintf(w, "%s{method=%q} %s\n", metric.name, method, metric.value(snap[method])); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Span is the subset of a tracing span used by TracingHook. An OpenTelemetry trace.Span satisfies it through a
+// small adapter.
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// Tracer starts spans for TracingHook. An OpenTelemetry trace.Tracer satisfies it through a small adapter.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// spanKey is the context key under which TracingHook keeps the span of a request.
+type spanKey struct{}
+
+// TracingHook returns a Hook that wraps every request in a span named after the API method, e.g.
+// "bluecat.getEntityById". The context returned by the tracer is used for the request.
+func TracingHook(t Tracer) Hook {
+	return HookFuncs{
+		Before: func(ctx context.Context, req *RequestInfo) context.Context {
+			ctx, span := t.Start(ctx, "bluecat."+req.Method)
+			span.SetAttribute("http.method", req.HTTPMethod)
+			span.SetAttribute("http.url", req.URL)
+			span.SetAttribute("bluecat.attempt", req.Attempt)
+
+			return context.WithValue(ctx, spanKey{}, span)
+		},
+		After: func(ctx context.Context, resp *ResponseInfo) {
+			span, ok := ctx.Value(spanKey{}).(Span)
+			if !ok {
+				return
+			}
+
+			span.SetAttribute("http.status_code", resp.StatusCode)
+			if resp.Err != nil {
+				span.RecordError(resp.Err)
+			} else if resp.Failed() {
+				span.RecordError(fmt.Errorf("%d status - %s response", resp.StatusCode, resp.Request.Method))
+			}
+			span.End()
+		},
+	}
+}
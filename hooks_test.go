@@ -0,0 +1,94 @@
+package bluecat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// testPassword contains characters that are changed by URL encoding.
+const testPassword = "p&ss w#rd+1"
+
+// checkRedacted fails the test if s contains testPassword, as given or URL encoded.
+func checkRedacted(t *testing.T, what, s string) {
+	t.Helper()
+
+	for _, secret := range []string{testPassword, url.QueryEscape(testPassword)} {
+		if strings.Contains(s, secret) {
+			t.Errorf("%s contains the password: %s", what, s)
+		}
+	}
+}
+
+func TestLoggingHookRedactsPassword(t *testing.T) {
+	var log strings.Builder
+	logger := func(msg string, keyvals ...interface{}) {
+		fmt.Fprintln(&log, append([]interface{}{msg}, keyvals...)...)
+	}
+
+	t.Run("login", func(t *testing.T) {
+		log.Reset()
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("password") != testPassword {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			fmt.Fprint(w, "Session Token-> BAMAuthToken: token1= <- for User : admin")
+		}))
+		defer ts.Close()
+
+		if _, err := NewSession(ts.URL, "admin", testPassword, WithHooks(LoggingHook(logger))); err != nil {
+			t.Fatalf("NewSession: %v", err)
+		}
+
+		if !strings.Contains(log.String(), "login") {
+			t.Fatalf("LoggingHook did not log the login: %s", log.String())
+		}
+		checkRedacted(t, "log", log.String())
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		log.Reset()
+		transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("cannot reach %s: %w", r.URL, context.DeadlineExceeded)
+		})
+
+		_, err := NewSession("http://bam.example.com", "admin", testPassword, WithTransport(transport), WithHooks(LoggingHook(logger)))
+		if err == nil {
+			t.Fatal("NewSession error = nil, want the transport error")
+		}
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("NewSession error = %v, want it to match context.DeadlineExceeded", err)
+		}
+
+		if !strings.Contains(log.String(), "error") {
+			t.Fatalf("LoggingHook did not log the error: %s", log.String())
+		}
+		checkRedacted(t, "log", log.String())
+		checkRedacted(t, "error", err.Error())
+	})
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "http://bam/Services/REST/v1/getEntityById?id=4", want: "http://bam/Services/REST/v1/getEntityById?id=4"},
+		{in: "http://bam/Services/REST/v1/login?password=" + url.QueryEscape(testPassword) + "&username=admin", want: "http://bam/Services/REST/v1/login?password=REDACTED&username=admin"},
+		{in: "http://bam\x7f/login?password=secret", want: "http://bam\x7f/login?REDACTED"},
+	}
+
+	for _, tt := range tests {
+		if got := redactURL(tt.in); got != tt.want {
+			t.Errorf("redactURL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package bluecat
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"gopkg.in/resty.v1"
+)
+
+// Option configures the HTTP client used by a Bluecat session. Options are passed to NewSession.
+type Option func(*options) error
+
+// options holds the settings collected from each Option before the HTTP client is built.
+type options struct {
+	httpClient *http.Client
+	transport  http.RoundTripper
+	rootCAs    *x509.CertPool
+	certs      []tls.Certificate
+	proxy      *url.URL
+	timeout    time.Duration
+	insecure   bool
+
+	credentials  Credentials
+	loginOptions string
+
+	retry   *RetryPolicy
+	limiter *rateLimiter
+	hooks   []Hook
+}
+
+// WithHTTPClient uses the given *http.Client for all requests made by the session. Any TLS, proxy or
+// timeout options are applied on top of it.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *options) error {
+		if hc == nil {
+			return fmt.Errorf("nil http.Client - WithHTTPClient option")
+		}
+		o.httpClient = hc
+
+		return nil
+	}
+}
+
+// WithTransport uses the given http.RoundTripper for all requests made by the session. The TLS and proxy
+// options can only be combined with a transport of type *http.Transport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(o *options) error {
+		if rt == nil {
+			return fmt.Errorf("nil http.RoundTripper - WithTransport option")
+		}
+		o.transport = rt
+
+		return nil
+	}
+}
+
+// WithCABundle adds the PEM encoded certificates in the file at `path` to the set of root CAs used to
+// verify the Bluecat server certificate.
+func WithCABundle(path string) Option {
+	return func(o *options) error {
+		pem, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%w - WithCABundle option", err)
+		}
+
+		if o.rootCAs == nil {
+			o.rootCAs = x509.NewCertPool()
+		}
+
+		if !o.rootCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s - WithCABundle option", path)
+		}
+
+		return nil
+	}
+}
+
+// WithRootCAs uses the given certificate pool to verify the Bluecat server certificate.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(o *options) error {
+		o.rootCAs = pool
+
+		return nil
+	}
+}
+
+// WithClientCertificates presents the given certificates to the Bluecat server for mutual TLS authentication.
+func WithClientCertificates(certs ...tls.Certificate) Option {
+	return func(o *options) error {
+		o.certs = append(o.certs, certs...)
+
+		return nil
+	}
+}
+
+// WithProxy sends all requests through the proxy at `proxyurl`, e.g. "http://proxy.example.com:8080".
+func WithProxy(proxyurl string) Option {
+	return func(o *options) error {
+		u, err := url.Parse(proxyurl)
+		if err != nil {
+			return fmt.Errorf("%w - WithProxy option", err)
+		}
+		o.proxy = u
+
+		return nil
+	}
+}
+
+// WithTimeout sets the overall timeout for each request made by the session.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) error {
+		o.timeout = d
+
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the Bluecat server certificate. This should only be used
+// against lab or test servers that have self-signed certificates.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) error {
+		o.insecure = true
+
+		return nil
+	}
+}
+
+// WithCredentials logs in with the username and password returned by c instead of the ones given to NewSession,
+// e.g. WithCredentials(EnvCredentials("BAM_USER", "BAM_PASS")). The user and pass arguments of NewSession can be
+// left empty when this option is used.
+func WithCredentials(c Credentials) Option {
+	return func(o *options) error {
+		if c == nil {
+			return fmt.Errorf("nil Credentials - WithCredentials option")
+		}
+		o.credentials = c
+
+		return nil
+	}
+}
+
+// WithLoginOptions logs in through the loginWithOptions endpoint with the given `loginoptions` string, e.g.
+// "isReadOnly=true", instead of the plain login endpoint.
+func WithLoginOptions(loginoptions string) Option {
+	return func(o *options) error {
+		o.loginOptions = loginoptions
+
+		return nil
+	}
+}
+
+// newClient builds the resty client for a session from the collected options.
+func newClient(o *options) (*resty.Client, error) {
+	var client *resty.Client
+	if o.httpClient != nil {
+		hc := *o.httpClient
+		client = resty.NewWithClient(&hc)
+	} else {
+		client = resty.New()
+	}
+
+	if o.transport != nil {
+		client.SetTransport(o.transport)
+	}
+
+	if o.timeout > 0 {
+		client.SetTimeout(o.timeout)
+	}
+
+	if o.rootCAs == nil && len(o.certs) == 0 && !o.insecure && o.proxy == nil {
+		return client, nil
+	}
+
+	hc := client.GetClient()
+	if hc.Transport == nil {
+		hc.Transport = http.DefaultTransport
+	}
+
+	shared, ok := hc.Transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("TLS and proxy options require an *http.Transport - newClient")
+	}
+
+	// Work on a copy so that a transport shared with other clients is left untouched.
+	transport := shared.Clone()
+	hc.Transport = transport
+
+	if o.rootCAs != nil || len(o.certs) > 0 || o.insecure {
+		config := &tls.Config{}
+		if transport.TLSClientConfig != nil {
+			config = transport.TLSClientConfig.Clone()
+		}
+
+		if o.rootCAs != nil {
+			config.RootCAs = o.rootCAs
+		}
+		config.Certificates = append(config.Certificates, o.certs...)
+		config.InsecureSkipVerify = o.insecure
+		transport.TLSClientConfig = config
+	}
+
+	if o.proxy != nil {
+		transport.Proxy = http.ProxyURL(o.proxy)
+	}
+
+	return client, nil
+}
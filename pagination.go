@@ -0,0 +1,300 @@
+package bluecat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrStopIteration can be returned by the callback of an iterator, such as GetEntitiesEach, to stop the iteration
+// early. The iterator then returns nil.
+var ErrStopIteration = errors.New("stop iteration")
+
+// ErrMaxItems is returned by an iterator when more items are available than PageOptions.MaxItems allows.
+var ErrMaxItems = errors.New("maximum number of items exceeded")
+
+// Default and maximum page sizes used by the iterators. The *ByHint methods of the API return at most 10
+// objects per call.
+const (
+	defaultPageSize = 100
+	maxHintPageSize = 10
+)
+
+// PageOptions controls how an iterator pages through the results of a count/start API method.
+type PageOptions struct {
+	// PageSize is the number of objects requested per call. It defaults to 100, and is capped at 10 for the
+	// *ByHint methods.
+	PageSize int
+
+	// MaxItems guards against unexpectedly large result sets. When it is greater than zero and more than MaxItems
+	// objects are found, the iteration stops with an error wrapping ErrMaxItems after MaxItems objects have been
+	// passed to the callback.
+	MaxItems int
+}
+
+// pager keeps track of an iteration.
+type pager struct {
+	size int
+	max  int
+	seen int
+}
+
+// pager returns a pager for the options, with the page size capped at limit when limit is greater than zero.
+func (o PageOptions) pager(limit int) *pager {
+	size := o.PageSize
+	if size <= 0 {
+		size = defaultPageSize
+	}
+
+	if limit > 0 && size > limit {
+		size = limit
+	}
+
+	return &pager{size: size, max: o.MaxItems}
+}
+
+// visit counts an item and calls fn for it, unless MaxItems has already been reached.
+func (p *pager) visit(fn func() error) error {
+	if p.max > 0 && p.seen >= p.max {
+		return fmt.Errorf("%w - %d items", ErrMaxItems, p.max)
+	}
+	p.seen++
+
+	return fn()
+}
+
+// paginate calls page with increasing start values until a page comes back short or an error occurs. page
+// returns the number of objects it fetched.
+func (p *pager) paginate(page func(count, start int) (int, error)) error {
+	for start := 0; ; start += p.size {
+		n, err := page(p.size, start)
+		if errors.Is(err, ErrStopIteration) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if n < p.size {
+			return nil
+		}
+	}
+}
+
+// eachEntity passes every entity of a page to fn.
+func (p *pager) eachEntity(page []APIEntity, fn func(APIEntity) error) error {
+	for _, e := range page {
+		e := e
+		if err := p.visit(func() error { return fn(e) }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eachAccessRight passes every access right of a page to fn.
+func (p *pager) eachAccessRight(page []APIAccessRight, fn func(APIAccessRight) error) error {
+	for _, a := range page {
+		a := a
+		if err := p.visit(func() error { return fn(a) }); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetEntitiesEach calls fn for every result of GetEntities, fetching one page after the other as needed.
+func (b *Bluecat) GetEntitiesEach(ctx context.Context, parentid int, objecttype ObjectType, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetEntitiesContext(ctx, parentid, objecttype, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetEntitiesByNameEach calls fn for every result of GetEntitiesByName, fetching one page after the other as needed.
+func (b *Bluecat) GetEntitiesByNameEach(ctx context.Context, name string, parentid int, objecttype ObjectType, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetEntitiesByNameContext(ctx, name, parentid, objecttype, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetEntitiesByNameUsingOptionsEach calls fn for every result of GetEntitiesByNameUsingOptions, fetching one page after the other as needed.
+func (b *Bluecat) GetEntitiesByNameUsingOptionsEach(ctx context.Context, name, options string, parentid int, objecttype ObjectType, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetEntitiesByNameUsingOptionsContext(ctx, name, options, parentid, objecttype, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// CustomSearchEach calls fn for every result of CustomSearch, fetching one page after the other as needed.
+func (b *Bluecat) CustomSearchEach(ctx context.Context, filters string, objecttype ObjectType, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.CustomSearchContext(ctx, filters, objecttype, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// SearchByCategoryEach calls fn for every result of SearchByCategory, fetching one page after the other as needed.
+func (b *Bluecat) SearchByCategoryEach(ctx context.Context, keyword string, category SearchCategory, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.SearchByCategoryContext(ctx, keyword, category, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// SearchByObjectTypesEach calls fn for every result of SearchByObjectTypes, fetching one page after the other as needed.
+func (b *Bluecat) SearchByObjectTypesEach(ctx context.Context, keyword string, objecttypes []ObjectType, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.SearchByObjectTypesContext(ctx, keyword, objecttypes, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetAccessRightsForEntityEach calls fn for every result of GetAccessRightsForEntity, fetching one page after the other as needed.
+func (b *Bluecat) GetAccessRightsForEntityEach(ctx context.Context, entityid int, opts PageOptions, fn func(APIAccessRight) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetAccessRightsForEntityContext(ctx, entityid, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachAccessRight(page, fn)
+	})
+}
+
+// GetAccessRightsForUserEach calls fn for every result of GetAccessRightsForUser, fetching one page after the other as needed.
+func (b *Bluecat) GetAccessRightsForUserEach(ctx context.Context, userid int, opts PageOptions, fn func(APIAccessRight) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetAccessRightsForUserContext(ctx, userid, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachAccessRight(page, fn)
+	})
+}
+
+// GetLinkedEntitiesEach calls fn for every result of GetLinkedEntities, fetching one page after the other as needed.
+func (b *Bluecat) GetLinkedEntitiesEach(ctx context.Context, entityid int, linkedtype ObjectType, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(0)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetLinkedEntitiesContext(ctx, entityid, linkedtype, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetAliasesByHintEach calls fn for every result of GetAliasesByHint, fetching one page after the other as needed.
+func (b *Bluecat) GetAliasesByHintEach(ctx context.Context, options string, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(maxHintPageSize)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetAliasesByHintContext(ctx, options, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetHostRecordsByHintEach calls fn for every result of GetHostRecordsByHint, fetching one page after the other as needed.
+func (b *Bluecat) GetHostRecordsByHintEach(ctx context.Context, options string, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(maxHintPageSize)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetHostRecordsByHintContext(ctx, options, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetIP4NetworksByHintEach calls fn for every result of GetIP4NetworksByHint, fetching one page after the other as needed.
+func (b *Bluecat) GetIP4NetworksByHintEach(ctx context.Context, containerid int, options string, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(maxHintPageSize)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetIP4NetworksByHintContext(ctx, containerid, options, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetIP6ObjectsByHintEach calls fn for every result of GetIP6ObjectsByHint, fetching one page after the other as needed.
+func (b *Bluecat) GetIP6ObjectsByHintEach(ctx context.Context, containerid int, objecttype ObjectType, options string, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(maxHintPageSize)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetIP6ObjectsByHintContext(ctx, containerid, objecttype, options, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// GetZonesByHintEach calls fn for every result of GetZonesByHint, fetching one page after the other as needed.
+func (b *Bluecat) GetZonesByHintEach(ctx context.Context, containerid int, options string, opts PageOptions, fn func(APIEntity) error) error {
+	p := opts.pager(maxHintPageSize)
+	return p.paginate(func(count, start int) (int, error) {
+		page, err := b.GetZonesByHintContext(ctx, containerid, options, count, start)
+		if err != nil {
+			return 0, err
+		}
+
+		return len(page), p.eachEntity(page, fn)
+	})
+}
+
+// allEntities returns every result of GetEntities for the parent `parentid` and the type `objecttype`.
+func (b *Bluecat) allEntities(ctx context.Context, parentid int, objecttype ObjectType) ([]APIEntity, error) {
+	var results []APIEntity
+	err := b.GetEntitiesEach(ctx, parentid, objecttype, PageOptions{}, func(e APIEntity) error {
+		results = append(results, e)
+		return nil
+	})
+
+	return results, err
+}
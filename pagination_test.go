@@ -0,0 +1,123 @@
+package bluecat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestPager(t *testing.T) {
+	errPage := errors.New("page failed")
+
+	tests := []struct {
+		name      string
+		opts      PageOptions
+		limit     int
+		total     int
+		stopAt    int
+		failAt    int
+		wantSeen  int
+		wantPages int
+		wantErr   error
+	}{
+		{name: "empty", total: 0, wantSeen: 0, wantPages: 1},
+		{name: "one short page", opts: PageOptions{PageSize: 10}, total: 7, wantSeen: 7, wantPages: 1},
+		{name: "several pages", opts: PageOptions{PageSize: 10}, total: 25, wantSeen: 25, wantPages: 3},
+		{name: "exact multiple", opts: PageOptions{PageSize: 10}, total: 20, wantSeen: 20, wantPages: 3},
+		{name: "default page size", total: 150, wantSeen: 150, wantPages: 2},
+		{name: "capped page size", opts: PageOptions{PageSize: 50}, limit: 10, total: 25, wantSeen: 25, wantPages: 3},
+		{name: "stop iteration", opts: PageOptions{PageSize: 10}, total: 25, stopAt: 12, wantSeen: 12, wantPages: 2},
+		{name: "max items exceeded", opts: PageOptions{PageSize: 10, MaxItems: 15}, total: 25, wantSeen: 15, wantPages: 2, wantErr: ErrMaxItems},
+		{name: "max items reached", opts: PageOptions{PageSize: 10, MaxItems: 25}, total: 25, wantSeen: 25, wantPages: 3},
+		{name: "page error", opts: PageOptions{PageSize: 10}, total: 25, failAt: 2, wantSeen: 10, wantPages: 2, wantErr: errPage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.opts.pager(tt.limit)
+			seen, pages := 0, 0
+			err := p.paginate(func(count, start int) (int, error) {
+				pages++
+				if tt.limit > 0 && count > tt.limit {
+					t.Errorf("count = %d, want at most %d", count, tt.limit)
+				}
+
+				if tt.failAt == pages {
+					return 0, errPage
+				}
+
+				var page []APIEntity
+				for i := start; i < start+count && i < tt.total; i++ {
+					page = append(page, APIEntity{ID: int64(i + 1)})
+				}
+
+				return len(page), p.eachEntity(page, func(e APIEntity) error {
+					seen++
+					if e.ID != int64(seen) {
+						t.Errorf("entity %d has ID %d, want %d", seen, e.ID, seen)
+					}
+
+					if seen == tt.stopAt {
+						return ErrStopIteration
+					}
+
+					return nil
+				})
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if seen != tt.wantSeen {
+				t.Errorf("seen = %d, want %d", seen, tt.wantSeen)
+			}
+
+			if pages != tt.wantPages {
+				t.Errorf("pages = %d, want %d", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestGetEntitiesEach(t *testing.T) {
+	const total = 23
+	b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := strconv.Atoi(q.Get("start"))
+		count, _ := strconv.Atoi(q.Get("count"))
+
+		page := []APIEntity{}
+		for i := start; i < start+count && i < total; i++ {
+			page = append(page, APIEntity{ID: int64(i + 1), Name: "net" + strconv.Itoa(i+1), Type: q.Get("type")})
+		}
+
+		json.NewEncoder(w).Encode(page)
+	})
+	defer ts.Close()
+
+	var ids []int64
+	err := b.GetEntitiesEach(context.Background(), 1, ObjectTypeIP4Network, PageOptions{PageSize: 10}, func(e APIEntity) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("GetEntitiesEach: %v", err)
+	}
+
+	if len(ids) != total || ids[0] != 1 || ids[total-1] != total {
+		t.Errorf("GetEntitiesEach visited %v, want IDs 1 to %d", ids, total)
+	}
+
+	if n := ts.count("getEntities"); n != 3 {
+		t.Errorf("getEntities calls = %d, want 3", n)
+	}
+
+	all, err := b.allEntities(context.Background(), 1, ObjectTypeIP4Network)
+	if err != nil || len(all) != total {
+		t.Errorf("allEntities = %d entities, %v, want %d entities", len(all), err, total)
+	}
+}
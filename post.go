@@ -1,65 +1,891 @@
 package bluecat
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"strings"
-
-	"gopkg.in/resty.v1"
 )
 
-// addACL
+// AddACL adds a DNS access control list to a configuration.
+//
+// Parameter `configurationid` is the object ID of the configuration to which the ACL is added. Parameter `name` is
+// the name of the ACL. Parameter `matchlist` holds the match list elements of the ACL: IP addresses, networks in CIDR
+// notation, names of other ACLs or the keywords any, none, localhost and localnets. An element preceded by an
+// exclamation mark (!) is excluded from the match list.
+//
+// Parameter `properties` adds object properties, including user-defined fields, and is sent as it is. The match list
+// is appended as the aclValues property, so `properties` must not set aclValues itself.
+//
+// Returns the object ID of the new ACL.
+func (b *Bluecat) AddACL(configurationid int, name string, matchlist []string, properties string) (int64, error) {
+	return b.AddACLContext(context.Background(), configurationid, name, matchlist, properties)
+}
+
+// AddACLContext performs AddACL using ctx to cancel or time out the request.
+func (b *Bluecat) AddACLContext(ctx context.Context, configurationid int, name string, matchlist []string, properties string) (int64, error) {
+	if name == "" {
+		return 0, fmt.Errorf("%w - empty name - AddACL request", ErrInvalidArgument)
+	}
+
+	if len(matchlist) == 0 {
+		return 0, fmt.Errorf("%w - empty match list - AddACL request", ErrInvalidArgument)
+	}
+
+	for _, element := range matchlist {
+		if strings.TrimSpace(element) == "" || strings.Contains(element, ",") {
+			return 0, fmt.Errorf("%w - invalid match list element %q - AddACL request", ErrInvalidArgument, element)
+		}
+	}
+
+	if _, ok := ParseProperties(properties)["aclValues"]; ok {
+		return 0, fmt.Errorf("%w - aclValues property is set by the match list - AddACL request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configurationid)},
+		"name":            {name},
+		"properties":      {appendProperty(properties, "aclValues", strings.Join(matchlist, ","))},
+	}
+	resp, err := b.post(ctx, "addACL", params)
 
-// addAccessRight
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddACL request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddACL response", err)
+	}
+
+	return id, nil
+}
+
+// AddAccessRight adds an access right to an object.
+//
+// Parameter `entityid` is the object ID of the object to which the access right is assigned; use 0 to assign a
+// system-level access right. Parameter `userid` is the object ID of the user or group to which the access right
+// applies. Parameter `value` is the default access right for the object.
+//
+// Parameter `overrides` sets a different access right for specific object types below the object, and can be nil.
+// Parameter `properties` adds object properties such as workflowLevel, deploymentAllowed and
+// quickDeploymentAllowed.
+//
+// Returns the object ID of the new access right.
+func (b *Bluecat) AddAccessRight(entityid, userid int, value AccessRight, overrides AccessOverrides, properties string) (int64, error) {
+	return b.AddAccessRightContext(context.Background(), entityid, userid, value, overrides, properties)
+}
+
+// AddAccessRightContext performs AddAccessRight using ctx to cancel or time out the request.
+func (b *Bluecat) AddAccessRightContext(ctx context.Context, entityid, userid int, value AccessRight, overrides AccessOverrides, properties string) (int64, error) {
+	if err := value.validate(); err != nil {
+		return 0, fmt.Errorf("%w - AddAccessRight request", err)
+	}
+
+	if err := overrides.validate(); err != nil {
+		return 0, fmt.Errorf("%w - AddAccessRight request", err)
+	}
+
+	params := url.Values{
+		"entityId":   {strconv.Itoa(entityid)},
+		"userId":     {strconv.Itoa(userid)},
+		"value":      {string(value)},
+		"overrides":  {overrides.Encode()},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addAccessRight", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddAccessRight request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddAccessRight response", err)
+	}
+
+	return id, nil
+}
 
 // addAdditionalIPAddresses
 
-// addAliasRecord
+// AddAliasRecord adds an alias (CNAME) record.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `absolutename` is the FQDN
+// of the record. If you are adding a record in a zone that is linked to an incremental naming policy, add a single
+// hash sign (#) at the appropriate location in the FQDN.
+//
+// Parameter `linkedrecordname` is the FQDN of the host record or external host record that the alias points to.
+//
+// Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set this value to -1. Parameter
+// `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new alias record.
+func (b *Bluecat) AddAliasRecord(viewid int, absolutename, linkedrecordname string, ttl int, properties string) (int64, error) {
+	return b.AddAliasRecordContext(context.Background(), viewid, absolutename, linkedrecordname, ttl, properties)
+}
+
+// AddAliasRecordContext performs AddAliasRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddAliasRecordContext(ctx context.Context, viewid int, absolutename, linkedrecordname string, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddAliasRecord request", ErrInvalidArgument)
+	}
+
+	if linkedrecordname == "" {
+		return 0, fmt.Errorf("%w - empty linked record name - AddAliasRecord request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"viewId":           {strconv.Itoa(viewid)},
+		"absoluteName":     {absolutename},
+		"linkedRecordName": {linkedrecordname},
+		"ttl":              {strconv.Itoa(ttl)},
+		"properties":       {properties},
+	}
+	resp, err := b.post(ctx, "addAliasRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddAliasRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddAliasRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddBulkHostRecord adds host records for a series of consecutive IPv4 addresses in a network, all with the same
+// name.
+//
+// Parameter `viewid` is the object ID of the view to which the host records are added. Parameter `absolutename` is the
+// FQDN of the host records. Parameter `ttl` is the time-to-live (TTL) value for the records. To ignore the TTL, set
+// this value to -1.
+//
+// Parameter `networkid` is the object ID of the IPv4 network in which the addresses are allocated. Parameter
+// `startaddress` is the first IPv4 address to use; leave it empty to start at the first available address of the
+// network. Parameter `count` is the number of host records to add.
+//
+// Parameter `properties` adds object properties, including comments and user-defined fields. Set the
+// excludeDHCPRange property to true to skip the addresses that are in DHCP ranges.
+//
+// Returns one APIEntity for each host record that was added, which can be converted with AsHostRecord.
+func (b *Bluecat) AddBulkHostRecord(viewid int, absolutename string, ttl, networkid int, startaddress string, count int, properties string) ([]APIEntity, error) {
+	return b.AddBulkHostRecordContext(context.Background(), viewid, absolutename, ttl, networkid, startaddress, count, properties)
+}
+
+// AddBulkHostRecordContext performs AddBulkHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddBulkHostRecordContext(ctx context.Context, viewid int, absolutename string, ttl, networkid int, startaddress string, count int, properties string) ([]APIEntity, error) {
+	var results []APIEntity
+
+	if absolutename == "" {
+		return nil, fmt.Errorf("%w - empty absolute name - AddBulkHostRecord request", ErrInvalidArgument)
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("%w - count must be at least 1 - AddBulkHostRecord request", ErrInvalidArgument)
+	}
+
+	if startaddress != "" {
+		if err := validateAddresses([]string{startaddress}); err != nil {
+			return nil, fmt.Errorf("%w - AddBulkHostRecord request", err)
+		}
+	}
+
+	params := url.Values{
+		"viewId":            {strconv.Itoa(viewid)},
+		"absoluteName":      {absolutename},
+		"ttl":               {strconv.Itoa(ttl)},
+		"networkId":         {strconv.Itoa(networkid)},
+		"startAddress":      {startaddress},
+		"numberOfAddresses": {strconv.Itoa(count)},
+		"properties":        {properties},
+	}
+	resp, err := b.post(ctx, "addBulkHostRecord", params)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w - AddBulkHostRecord request", err)
+	}
+
+	if err := json.Unmarshal([]byte(resp.String()), &results); err != nil {
+		return nil, fmt.Errorf("%w - AddBulkHostRecord JSON parse", err)
+	}
+
+	return results, nil
+}
+
+// AddCustomOptionDefinition defines a custom DHCPv4 option in a configuration, such as option 150 (TFTP servers) or
+// option 242 for IP phones, which can then be added with AddDHCPClientDeploymentOption.
+//
+// Parameter `configurationid` is the object ID of the configuration in which the option is defined. Parameter `name`
+// is the name of the option. Parameter `code` is the option code, from 1 to 254. Parameter `optiontype` is the type of
+// the value of the option. Parameter `allowmultiple` allows the option to hold a list of values of that type.
+// Parameter `properties` adds object properties, including user-defined fields.
+//
+// Returns the object ID of the new custom option definition.
+func (b *Bluecat) AddCustomOptionDefinition(configurationid int, name string, code int, optiontype OptionValueType, allowmultiple bool, properties string) (int64, error) {
+	return b.AddCustomOptionDefinitionContext(context.Background(), configurationid, name, code, optiontype, allowmultiple, properties)
+}
+
+// AddCustomOptionDefinitionContext performs AddCustomOptionDefinition using ctx to cancel or time out the request.
+func (b *Bluecat) AddCustomOptionDefinitionContext(ctx context.Context, configurationid int, name string, code int, optiontype OptionValueType, allowmultiple bool, properties string) (int64, error) {
+	if err := validateOptionDefinition(name, code, optiontype); err != nil {
+		return 0, fmt.Errorf("%w - AddCustomOptionDefinition request", err)
+	}
+
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configurationid)},
+		"name":            {name},
+		"optionId":        {strconv.Itoa(code)},
+		"optionType":      {string(optiontype)},
+		"allowMultiple":   {strconv.FormatBool(allowmultiple)},
+		"properties":      {properties},
+	}
+	resp, err := b.post(ctx, "addCustomOptionDefinition", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddCustomOptionDefinition request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddCustomOptionDefinition response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCP4RangeBySize adds an IPv4 DHCP range by offset and size within an IPv4 network.
+//
+// Parameter `networkid` is the object ID of the IPv4 network in which the range is added. Parameter `offset` is the
+// position of the first address of the range, counted from the network address. Parameter `size` is the number of
+// addresses in the range. Parameter `properties` adds object properties, including comments and user-defined
+// fields.
+//
+// Before the request is sent, the range is checked to lie within the network and not to overlap another DHCP range of
+// the network. An error wrapping ErrInvalidArgument is returned if it does not pass these checks.
+//
+// Returns the object ID of the new DHCP range.
+func (b *Bluecat) AddDHCP4RangeBySize(networkid, offset, size int, properties string) (int64, error) {
+	return b.AddDHCP4RangeBySizeContext(context.Background(), networkid, offset, size, properties)
+}
+
+// AddDHCP4RangeBySizeContext performs AddDHCP4RangeBySize using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCP4RangeBySizeContext(ctx context.Context, networkid, offset, size int, properties string) (int64, error) {
+	if offset < 0 || size < 1 {
+		return 0, fmt.Errorf("%w - invalid offset %d or size %d - AddDHCP4RangeBySize request", ErrInvalidArgument, offset, size)
+	}
+
+	lo, hi, err := b.networkBounds(ctx, networkid)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4RangeBySize request", err)
+	}
+
+	if uint64(offset)+uint64(size) > uint64(hi-lo)+1 {
+		return 0, fmt.Errorf("%w - offset %d and size %d exceed network %s-%s - AddDHCP4RangeBySize request",
+			ErrInvalidArgument, offset, size, formatIP4(lo), formatIP4(hi))
+	}
+
+	first := lo + uint32(offset)
+	if err := b.checkDHCP4Range(ctx, networkid, lo, hi, first, first+uint32(size)-1); err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4RangeBySize request", err)
+	}
+
+	params := url.Values{
+		"networkId":  {strconv.Itoa(networkid)},
+		"offset":     {strconv.Itoa(offset)},
+		"size":       {strconv.Itoa(size)},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addDHCP4RangeBySize", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4RangeBySize request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4RangeBySize response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCP4Range adds an IPv4 DHCP range to an IPv4 network.
+//
+// Parameter `networkid` is the object ID of the IPv4 network in which the range is added. Parameters `start` and
+// `end` are the first and the last IPv4 address of the range. Parameter `properties` adds object properties,
+// including comments and user-defined fields.
+//
+// Before the request is sent, the range is checked to lie within the network and not to overlap another DHCP range of
+// the network. An error wrapping ErrInvalidArgument is returned if it does not pass these checks.
+//
+// Returns the object ID of the new DHCP range.
+func (b *Bluecat) AddDHCP4Range(networkid int, start, end, properties string) (int64, error) {
+	return b.AddDHCP4RangeContext(context.Background(), networkid, start, end, properties)
+}
+
+// AddDHCP4RangeContext performs AddDHCP4Range using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCP4RangeContext(ctx context.Context, networkid int, start, end, properties string) (int64, error) {
+	first, last, err := parseIP4Range(start, end)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4Range request", err)
+	}
+
+	lo, hi, err := b.networkBounds(ctx, networkid)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4Range request", err)
+	}
+
+	if err := b.checkDHCP4Range(ctx, networkid, lo, hi, first, last); err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4Range request", err)
+	}
+
+	params := url.Values{
+		"networkId":  {strconv.Itoa(networkid)},
+		"start":      {start},
+		"end":        {end},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addDHCP4Range", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4Range request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP4Range response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCP6ClientDeploymentOption adds a DHCPv6 client deployment option to an object.
+//
+// Parameter `entityid` is the object ID of the configuration, server group, server, block, network, range or address to
+// which the option is added. Parameter `name` is the name of the option, e.g. "dns-server" or "domain-search-list".
+// Parameter `value` is the value of the option. Parameter `properties` adds object properties; set the server property
+// to the object ID of a server or server group to limit the option to it.
+//
+// Returns the object ID of the new DHCPv6 client deployment option.
+func (b *Bluecat) AddDHCP6ClientDeploymentOption(entityid int, name, value, properties string) (int64, error) {
+	return b.AddDHCP6ClientDeploymentOptionContext(context.Background(), entityid, name, value, properties)
+}
+
+// AddDHCP6ClientDeploymentOptionContext performs AddDHCP6ClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCP6ClientDeploymentOptionContext(ctx context.Context, entityid int, name, value, properties string) (int64, error) {
+	id, err := b.addDeploymentOption(ctx, "addDHCP6ClientDeploymentOption", entityid, name, value, properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6ClientDeploymentOption request", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCP6RangeBySize adds an IPv6 DHCP range by start address and size within an IPv6 network.
+//
+// Parameter `networkid` is the object ID of the IPv6 network in which the range is added. Parameter `start` is the
+// first IPv6 address of the range; leave it empty to let Address Manager pick the first free address of the network.
+// Parameter `size` is the number of addresses in the range. Parameter `properties` adds object properties, including
+// comments and user-defined fields.
+//
+// Before the request is sent, when a start address is given, the range from it to the start address plus size - 1 is
+// checked to lie within the network and not to overlap another DHCP range. An error wrapping ErrInvalidArgument is
+// returned if it does not.
+//
+// Returns the object ID of the new DHCP range.
+func (b *Bluecat) AddDHCP6RangeBySize(networkid int, start string, size int, properties string) (int64, error) {
+	return b.AddDHCP6RangeBySizeContext(context.Background(), networkid, start, size, properties)
+}
+
+// AddDHCP6RangeBySizeContext performs AddDHCP6RangeBySize using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCP6RangeBySizeContext(ctx context.Context, networkid int, start string, size int, properties string) (int64, error) {
+	if size < 1 {
+		return 0, fmt.Errorf("%w - invalid size %d - AddDHCP6RangeBySize request", ErrInvalidArgument, size)
+	}
+
+	if start != "" {
+		first, err := parseIP6(start)
+		if err != nil {
+			return 0, fmt.Errorf("%w - AddDHCP6RangeBySize request", err)
+		}
+
+		last, err := addIP6(first, uint64(size)-1)
+		if err != nil {
+			return 0, fmt.Errorf("%w - AddDHCP6RangeBySize request", err)
+		}
+
+		if err := b.checkDHCP6Range(ctx, networkid, first, last); err != nil {
+			return 0, fmt.Errorf("%w - AddDHCP6RangeBySize request", err)
+		}
+	}
+
+	params := url.Values{
+		"networkId":  {strconv.Itoa(networkid)},
+		"start":      {start},
+		"size":       {strconv.Itoa(size)},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addDHCP6RangeBySize", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6RangeBySize request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6RangeBySize response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCP6Range adds an IPv6 DHCP range to an IPv6 network.
+//
+// Parameter `networkid` is the object ID of the IPv6 network in which the range is added. Parameters `start` and
+// `end` are the first and the last IPv6 address of the range. Parameter `properties` adds object properties,
+// including comments and user-defined fields.
+//
+// Before the request is sent, the range is checked to lie within the network and not to overlap another DHCP range of
+// the network. An error wrapping ErrInvalidArgument is returned if it does not pass these checks.
+//
+// Returns the object ID of the new DHCP range.
+func (b *Bluecat) AddDHCP6Range(networkid int, start, end, properties string) (int64, error) {
+	return b.AddDHCP6RangeContext(context.Background(), networkid, start, end, properties)
+}
+
+// AddDHCP6RangeContext performs AddDHCP6Range using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCP6RangeContext(ctx context.Context, networkid int, start, end, properties string) (int64, error) {
+	first, last, err := parseIP6Range(start, end)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6Range request", err)
+	}
+
+	if err := b.checkDHCP6Range(ctx, networkid, first, last); err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6Range request", err)
+	}
+
+	params := url.Values{
+		"networkId":  {strconv.Itoa(networkid)},
+		"start":      {start},
+		"end":        {end},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addDHCP6Range", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6Range request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6Range response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCP6ServiceDeploymentOption adds a DHCPv6 service deployment option to an object.
+//
+// Parameter `entityid` is the object ID of the configuration, server group, server, block, network, range or address to
+// which the option is added. Parameter `name` is the name of the option, e.g. "default-lease-time" or
+// "preferred-lifetime". Parameter `value` is the value of the option. Parameter `properties` adds object properties;
+// set the server property to the object ID of a server or server group to limit the option to it.
+//
+// Returns the object ID of the new DHCPv6 service deployment option.
+func (b *Bluecat) AddDHCP6ServiceDeploymentOption(entityid int, name, value, properties string) (int64, error) {
+	return b.AddDHCP6ServiceDeploymentOptionContext(context.Background(), entityid, name, value, properties)
+}
+
+// AddDHCP6ServiceDeploymentOptionContext performs AddDHCP6ServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCP6ServiceDeploymentOptionContext(ctx context.Context, entityid int, name, value, properties string) (int64, error) {
+	id, err := b.addDeploymentOption(ctx, "addDHCP6ServiceDeploymentOption", entityid, name, value, properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCP6ServiceDeploymentOption request", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPClientDeploymentOption adds a DHCPv4 client deployment option to an object.
+//
+// Parameter `entityid` is the object ID of the configuration, server group, server, block, network, range, address,
+// MAC address, DHCP match class or DHCP sub-class to which the option is added. Parameter `name` is the name of the
+// option, e.g. DHCPOptionRouter or DHCPOptionDomainNameServer. Parameter `value` is the value of the option; the
+// DHCPOption encoders build the name and value of common options. Parameter `properties` adds object properties; set
+// the server property to the object ID of a server or server group to limit the option to it.
+//
+// Returns the object ID of the new DHCPv4 client deployment option.
+func (b *Bluecat) AddDHCPClientDeploymentOption(entityid int, name, value, properties string) (int64, error) {
+	return b.AddDHCPClientDeploymentOptionContext(context.Background(), entityid, name, value, properties)
+}
+
+// AddDHCPClientDeploymentOptionContext performs AddDHCPClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPClientDeploymentOptionContext(ctx context.Context, entityid int, name, value, properties string) (int64, error) {
+	id, err := b.addDeploymentOption(ctx, "addDHCPClientDeploymentOption", entityid, name, value, properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPClientDeploymentOption request", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPDeploymentRole adds a DHCP deployment role to an object, which deploys the DHCP service for the object to
+// a server.
+//
+// Parameter `entityid` is the object ID of the configuration, block, network or DHCP class to which the role is
+// added. Parameter `serverinterfaceid` is the object ID of the server interface of the DHCP server. Parameter
+// `roletype` is the type of the role. Parameter `properties` adds object properties; use
+// AddDHCPFailoverDeploymentRole to add a failover pair.
+//
+// Returns the object ID of the new DHCP deployment role.
+func (b *Bluecat) AddDHCPDeploymentRole(entityid, serverinterfaceid int, roletype DHCPRoleType, properties string) (int64, error) {
+	return b.AddDHCPDeploymentRoleContext(context.Background(), entityid, serverinterfaceid, roletype, properties)
+}
+
+// AddDHCPDeploymentRoleContext performs AddDHCPDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPDeploymentRoleContext(ctx context.Context, entityid, serverinterfaceid int, roletype DHCPRoleType, properties string) (int64, error) {
+	if err := roletype.validate(); err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPDeploymentRole request", err)
+	}
+
+	id, err := b.addDeploymentRole(ctx, "addDHCPDeploymentRole", entityid, serverinterfaceid, string(roletype), properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPDeploymentRole request", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPFailoverDeploymentRole adds a DHCP deployment role for a failover pair of DHCP servers to an object. The
+// role is a DHCPRoleMaster role on the primary server, with the secondaryServerInterfaceId property naming the
+// failover peer.
+//
+// Parameter `entityid` is the object ID of the configuration, block, network or DHCP class to which the role is
+// added. Parameters `primaryinterfaceid` and `secondaryinterfaceid` are the object IDs of the server interfaces of
+// the primary and the secondary DHCP server. Parameter `properties` adds object properties and is sent as it is, with
+// the secondaryServerInterfaceId property appended.
+//
+// Returns the object ID of the new DHCP deployment role.
+func (b *Bluecat) AddDHCPFailoverDeploymentRole(entityid, primaryinterfaceid, secondaryinterfaceid int, properties string) (int64, error) {
+	return b.AddDHCPFailoverDeploymentRoleContext(context.Background(), entityid, primaryinterfaceid, secondaryinterfaceid, properties)
+}
+
+// AddDHCPFailoverDeploymentRoleContext performs AddDHCPFailoverDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPFailoverDeploymentRoleContext(ctx context.Context, entityid, primaryinterfaceid, secondaryinterfaceid int, properties string) (int64, error) {
+	if primaryinterfaceid == secondaryinterfaceid {
+		return 0, fmt.Errorf("%w - primary and secondary server interface are the same - AddDHCPFailoverDeploymentRole request", ErrInvalidArgument)
+	}
+
+	if _, ok := ParseProperties(properties)["secondaryServerInterfaceId"]; ok {
+		return 0, fmt.Errorf("%w - secondaryServerInterfaceId property is set by secondaryinterfaceid - AddDHCPFailoverDeploymentRole request", ErrInvalidArgument)
+	}
+
+	properties = appendProperty(properties, "secondaryServerInterfaceId", strconv.Itoa(secondaryinterfaceid))
+
+	id, err := b.addDeploymentRole(ctx, "addDHCPDeploymentRole", entityid, primaryinterfaceid, string(DHCPRoleMaster), properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPFailoverDeploymentRole request", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPMatchClass adds a DHCP match class to a configuration. Clients are put in the class by the value of the
+// part of their request given by `criteria`, and the class can then be used to select DHCP ranges and to hand out
+// its own DHCP client deployment options, e.g. to PXE clients.
+//
+// Parameter `configurationid` is the object ID of the configuration to which the class is added. Parameter `name` is
+// the name of the class. Parameter `criteria` is what the class matches on. Parameter `properties` adds object
+// properties; the classes with the custom criteria take their expression from the customMatchRequest property, and
+// the other classes can be limited to a part of the matched value with the matchOffset and matchLength properties.
+//
+// Returns the object ID of the new DHCP match class.
+func (b *Bluecat) AddDHCPMatchClass(configurationid int, name string, criteria MatchCriteria, properties string) (int64, error) {
+	return b.AddDHCPMatchClassContext(context.Background(), configurationid, name, criteria, properties)
+}
+
+// AddDHCPMatchClassContext performs AddDHCPMatchClass using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPMatchClassContext(ctx context.Context, configurationid int, name string, criteria MatchCriteria, properties string) (int64, error) {
+	if name == "" {
+		return 0, fmt.Errorf("%w - empty name - AddDHCPMatchClass request", ErrInvalidArgument)
+	}
+
+	if err := criteria.validate(); err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPMatchClass request", err)
+	}
+
+	if (criteria == MatchCustom || criteria == MatchCustomIf) && ParseProperties(properties).Get("customMatchRequest") == "" {
+		return 0, fmt.Errorf("%w - missing customMatchRequest property - AddDHCPMatchClass request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"configurationId": {strconv.Itoa(configurationid)},
+		"name":            {name},
+		"matchCriteria":   {string(criteria)},
+		"properties":      {properties},
+	}
+	resp, err := b.post(ctx, "addDHCPMatchClass", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPMatchClass request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPMatchClass response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPServiceDeploymentOption adds a DHCPv4 service deployment option to an object.
+//
+// Parameter `entityid` is the object ID of the configuration, server group, server, block, network, range, address or
+// MAC address to which the option is added. Parameter `name` is the name of the option, e.g. DHCPOptionDefaultLeaseTime
+// or "ddns-updates". Parameter `value` is the value of the option; the DHCPOption encoders build the name and value of
+// common options. Parameter `properties` adds object properties; set the server property to the object ID of a server
+// or server group to limit the option to it.
+//
+// Returns the object ID of the new DHCPv4 service deployment option.
+func (b *Bluecat) AddDHCPServiceDeploymentOption(entityid int, name, value, properties string) (int64, error) {
+	return b.AddDHCPServiceDeploymentOptionContext(context.Background(), entityid, name, value, properties)
+}
+
+// AddDHCPServiceDeploymentOptionContext performs AddDHCPServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPServiceDeploymentOptionContext(ctx context.Context, entityid int, name, value, properties string) (int64, error) {
+	id, err := b.addDeploymentOption(ctx, "addDHCPServiceDeploymentOption", entityid, name, value, properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPServiceDeploymentOption request", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPSubClass adds a sub-class to a DHCP match class. A sub-class matches one value of the criteria of its
+// match class, e.g. one vendor class identifier, and can hand out its own DHCP client deployment options.
+//
+// Parameter `matchclassid` is the object ID of the match class. Parameter `value` is the matched value, such as
+// "PXEClient" for a match class on the vendor ID. Parameter `properties` adds object properties, including the
+// name of the sub-class and user-defined fields.
+//
+// Returns the object ID of the new DHCP sub-class.
+func (b *Bluecat) AddDHCPSubClass(matchclassid int, value, properties string) (int64, error) {
+	return b.AddDHCPSubClassContext(context.Background(), matchclassid, value, properties)
+}
+
+// AddDHCPSubClassContext performs AddDHCPSubClass using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPSubClassContext(ctx context.Context, matchclassid int, value, properties string) (int64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("%w - empty value - AddDHCPSubClass request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"matchClassId": {strconv.Itoa(matchclassid)},
+		"value":        {value},
+		"properties":   {properties},
+	}
+	resp, err := b.post(ctx, "addDHCPSubClass", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPSubClass request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPSubClass response", err)
+	}
+
+	return id, nil
+}
+
+// AddDHCPVendorDeploymentOption adds a DHCP vendor deployment option to an object.
+//
+// Parameter `parentid` is the object ID of the configuration, server group, server, block, network, range, address or
+// MAC address to which the option is added. Parameter `optionid` is the object ID of the vendor option definition.
+// Parameter `value` is the value of the option, in the format of the option definition. Parameter `properties` adds
+// object properties; set the server property to the object ID of a server or server group to limit the option to
+// it.
+//
+// Returns the object ID of the new DHCP vendor deployment option.
+func (b *Bluecat) AddDHCPVendorDeploymentOption(parentid, optionid int, value, properties string) (int64, error) {
+	return b.AddDHCPVendorDeploymentOptionContext(context.Background(), parentid, optionid, value, properties)
+}
+
+// AddDHCPVendorDeploymentOptionContext performs AddDHCPVendorDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) AddDHCPVendorDeploymentOptionContext(ctx context.Context, parentid, optionid int, value, properties string) (int64, error) {
+	params := url.Values{
+		"parentId":   {strconv.Itoa(parentid)},
+		"optionId":   {strconv.Itoa(optionid)},
+		"value":      {value},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addDHCPVendorDeploymentOption", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPVendorDeploymentOption request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDHCPVendorDeploymentOption response", err)
+	}
+
+	return id, nil
+}
+
+// addDNSDeploymentOption
+
+// AddDNSDeploymentRole adds a DNS deployment role to an object, which deploys the DNS service for the object to a
+// server.
+//
+// Parameter `entityid` is the object ID of the view, zone, block or network to which the role is added. Parameter
+// `serverinterfaceid` is the object ID of the server interface of the DNS server. Parameter `roletype` is the type
+// of the role, e.g. DNSRolePrimary, DNSRoleSecondary or DNSRoleForwarder. Parameter `properties` adds object
+// properties; for a role on a block or network, set the view property to the object ID of the view in which the
+// reverse zones are deployed.
+//
+// Returns the object ID of the new DNS deployment role.
+func (b *Bluecat) AddDNSDeploymentRole(entityid, serverinterfaceid int, roletype DNSRoleType, properties string) (int64, error) {
+	return b.AddDNSDeploymentRoleContext(context.Background(), entityid, serverinterfaceid, roletype, properties)
+}
+
+// AddDNSDeploymentRoleContext performs AddDNSDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) AddDNSDeploymentRoleContext(ctx context.Context, entityid, serverinterfaceid int, roletype DNSRoleType, properties string) (int64, error) {
+	if err := roletype.validate(); err != nil {
+		return 0, fmt.Errorf("%w - AddDNSDeploymentRole request", err)
+	}
+
+	id, err := b.addDeploymentRole(ctx, "addDNSDeploymentRole", entityid, serverinterfaceid, string(roletype), properties)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddDNSDeploymentRole request", err)
+	}
+
+	return id, nil
+}
 
-// addBulkHostRecord
+// addDeviceInstance
 
-// addCustomOptionDefinition
+// addDeviceSubtype
 
-// addDHCP4RangeBySize
+// addDeviceType
 
-// addDHCP4Range
+// addDevice
 
-// addDHCP6ClientDeploymentOption
+// addEntity
 
-// addDHCP6RangeBySize
+// AddEnumNumber adds an ENUM number object to an ENUM zone.
+//
+// Parameter `enumzoneid` is the object ID of the ENUM zone to which the number is added. Parameter `number` is the
+// number, which is appended to the prefix of the zone, e.g. "5555" in a zone with prefix "1800". Parameter
+// `properties` adds object properties, such as the name of the number and user-defined fields.
+//
+// Returns the object ID of the new ENUM number.
+func (b *Bluecat) AddEnumNumber(enumzoneid int, number, properties string) (int64, error) {
+	return b.AddEnumNumberContext(context.Background(), enumzoneid, number, properties)
+}
 
-// addDHCP6Range
+// AddEnumNumberContext performs AddEnumNumber using ctx to cancel or time out the request.
+func (b *Bluecat) AddEnumNumberContext(ctx context.Context, enumzoneid int, number, properties string) (int64, error) {
+	if err := validateDigits("number", number); err != nil {
+		return 0, fmt.Errorf("%w - AddEnumNumber request", err)
+	}
 
-// addDHCP6ServiceDeploymentOption
+	params := url.Values{
+		"enumZoneId": {strconv.Itoa(enumzoneid)},
+		"number":     {number},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addEnumNumber", params)
 
-// addDHCPClientDeploymentOption
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddEnumNumber request", err)
+	}
 
-// addDHCPDeploymentRole
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddEnumNumber response", err)
+	}
 
-// addDHCPServiceDeploymentOption
+	return id, nil
+}
 
-// addDHCPSubClass
+// AddEnumZone adds an ENUM zone, which delegates a range of telephone numbers.
+//
+// Parameter `parentid` is the object ID of the view or ENUM zone to which the zone is added. Parameter `prefix` is the
+// telephone number prefix of the zone, e.g. "1800". Parameter `properties` adds object properties, including
+// comments and user-defined fields.
+//
+// Returns the object ID of the new ENUM zone.
+func (b *Bluecat) AddEnumZone(parentid int, prefix, properties string) (int64, error) {
+	return b.AddEnumZoneContext(context.Background(), parentid, prefix, properties)
+}
 
-// addDHCPVendorDeploymentOption
+// AddEnumZoneContext performs AddEnumZone using ctx to cancel or time out the request.
+func (b *Bluecat) AddEnumZoneContext(ctx context.Context, parentid int, prefix, properties string) (int64, error) {
+	if err := validateDigits("prefix", prefix); err != nil {
+		return 0, fmt.Errorf("%w - AddEnumZone request", err)
+	}
 
-// addDNSDeploymentOption
+	params := url.Values{
+		"parentId":   {strconv.Itoa(parentid)},
+		"prefix":     {prefix},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addEnumZone", params)
 
-// addDNSDeploymentRole
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddEnumZone request", err)
+	}
 
-// addDeviceInstance
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddEnumZone response", err)
+	}
 
-// addDeviceSubtype
+	return id, nil
+}
 
-// addDeviceType
+// AddExternalHostRecord adds an external host record, which represents a host outside of Address Manager that
+// alias, MX and SRV records can link to.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `name` is the FQDN of the
+// external host. Parameter `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new external host record.
+func (b *Bluecat) AddExternalHostRecord(viewid int, name, properties string) (int64, error) {
+	return b.AddExternalHostRecordContext(context.Background(), viewid, name, properties)
+}
 
-// addDevice
+// AddExternalHostRecordContext performs AddExternalHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddExternalHostRecordContext(ctx context.Context, viewid int, name, properties string) (int64, error) {
+	if name == "" {
+		return 0, fmt.Errorf("%w - empty name - AddExternalHostRecord request", ErrInvalidArgument)
+	}
 
-// addEntity
+	params := url.Values{
+		"viewId":     {strconv.Itoa(viewid)},
+		"name":       {name},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, "addExternalHostRecord", params)
 
-// addEnumNumber
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddExternalHostRecord request", err)
+	}
 
-// addEnumZone
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddExternalHostRecord response", err)
+	}
 
-// addExternalHostRecord
+	return id, nil
+}
 
 // AddGenericRecord Adds generic records.
 //
@@ -78,21 +904,544 @@ import (
 // Parameter `viewid` is the object ID for the parent view to which you are adding the record.
 //
 // Returns the object ID for the new generic resource record.
-func (b *Bluecat) AddGenericRecord(absolutename, properties, rdata string, ttl int, objecttype string, viewid int) (string, error) {
-	req := fmt.Sprintf("https://%s%s/addGenericRecord?absoluteName=%s&rdata=%s&ttl=%d&type=%s&viewId=%d&properties=%s",
-		b.Server, b.URI, absolutename, rdata, ttl, objecttype, viewid, properties)
-	resp, err := resty.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Authorization", fmt.Sprintf("%s", b.AuthToken)).
-		Post(req)
+func (b *Bluecat) AddGenericRecord(absolutename, properties, rdata string, ttl int, objecttype string, viewid int) (int64, error) {
+	return b.AddGenericRecordContext(context.Background(), absolutename, properties, rdata, ttl, objecttype, viewid)
+}
+
+// AddGenericRecordContext performs AddGenericRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddGenericRecordContext(ctx context.Context, absolutename, properties, rdata string, ttl int, objecttype string, viewid int) (int64, error) {
+	params := url.Values{
+		"absoluteName": {absolutename},
+		"rdata":        {rdata},
+		"ttl":          {strconv.Itoa(ttl)},
+		"type":         {objecttype},
+		"viewId":       {strconv.Itoa(viewid)},
+		"properties":   {properties},
+	}
+	resp, err := b.post(ctx, "addGenericRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddGenericRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddGenericRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddHINFORecord adds a host information (HINFO) record.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `absolutename` is the FQDN
+// of the record. If you are adding a record in a zone that is linked to an incremental naming policy, add a single
+// hash sign (#) at the appropriate location in the FQDN.
+//
+// Parameter `cpu` is the CPU type of the host, and parameter `os` is its operating system.
+//
+// Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set this value to -1. Parameter
+// `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new HINFO record.
+func (b *Bluecat) AddHINFORecord(viewid int, absolutename, cpu, os string, ttl int, properties string) (int64, error) {
+	return b.AddHINFORecordContext(context.Background(), viewid, absolutename, cpu, os, ttl, properties)
+}
+
+// AddHINFORecordContext performs AddHINFORecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddHINFORecordContext(ctx context.Context, viewid int, absolutename, cpu, os string, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddHINFORecord request", ErrInvalidArgument)
+	}
+
+	if cpu == "" {
+		return 0, fmt.Errorf("%w - empty CPU - AddHINFORecord request", ErrInvalidArgument)
+	}
+
+	if os == "" {
+		return 0, fmt.Errorf("%w - empty operating system - AddHINFORecord request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"viewId":       {strconv.Itoa(viewid)},
+		"absoluteName": {absolutename},
+		"cpu":          {cpu},
+		"os":           {os},
+		"ttl":          {strconv.Itoa(ttl)},
+		"properties":   {properties},
+	}
+	resp, err := b.post(ctx, "addHINFORecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddHINFORecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddHINFORecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddHostRecord adds a host record, which links a name to one or more IPv4 or IPv6 addresses.
+//
+// Parameter `viewid` is the object ID of the view to which the host record is added. Parameter `absolutename` is the
+// FQDN of the host record. If you are adding a record in a zone that is linked to an incremental naming policy, add a
+// single hash sign (#) at the appropriate location in the FQDN.
+//
+// Parameter `addresses` holds the IP addresses of the host record. Parameter `reverse` creates the reverse (PTR)
+// records for the addresses. Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set
+// this value to -1.
+//
+// Parameter `properties` adds object properties, including comments and user-defined fields, and is sent as it is.
+// The reverse flag is appended as the reverseRecord property unless `properties` already sets reverseRecord.
+//
+// Returns the object ID of the new host record.
+func (b *Bluecat) AddHostRecord(viewid int, absolutename string, addresses []string, reverse bool, ttl int, properties string) (int64, error) {
+	return b.AddHostRecordContext(context.Background(), viewid, absolutename, addresses, reverse, ttl, properties)
+}
+
+// AddHostRecordContext performs AddHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddHostRecordContext(ctx context.Context, viewid int, absolutename string, addresses []string, reverse bool, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddHostRecord request", ErrInvalidArgument)
+	}
+
+	if len(addresses) == 0 {
+		return 0, fmt.Errorf("%w - no addresses - AddHostRecord request", ErrInvalidArgument)
+	}
+
+	if err := validateAddresses(addresses); err != nil {
+		return 0, fmt.Errorf("%w - AddHostRecord request", err)
+	}
+
+	if _, ok := ParseProperties(properties)["reverseRecord"]; !ok {
+		properties = appendProperty(properties, "reverseRecord", strconv.FormatBool(reverse))
+	}
+
+	params := url.Values{
+		"viewId":       {strconv.Itoa(viewid)},
+		"absoluteName": {absolutename},
+		"addresses":    {strings.Join(addresses, ",")},
+		"ttl":          {strconv.Itoa(ttl)},
+		"properties":   {properties},
+	}
+	resp, err := b.post(ctx, "addHostRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddHostRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddHostRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddMXRecord adds a mail exchanger (MX) record.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `absolutename` is the FQDN
+// of the record. If you are adding a record in a zone that is linked to an incremental naming policy, add a single
+// hash sign (#) at the appropriate location in the FQDN.
+//
+// Parameter `priority` is the preference of the mail exchanger, from 0 to 65535, where lower values are preferred.
+// Parameter `linkedrecordname` is the FQDN of the host record or external host record of the mail exchanger.
+//
+// Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set this value to -1. Parameter
+// `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new MX record.
+func (b *Bluecat) AddMXRecord(viewid int, absolutename string, priority int, linkedrecordname string, ttl int, properties string) (int64, error) {
+	return b.AddMXRecordContext(context.Background(), viewid, absolutename, priority, linkedrecordname, ttl, properties)
+}
+
+// AddMXRecordContext performs AddMXRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddMXRecordContext(ctx context.Context, viewid int, absolutename string, priority int, linkedrecordname string, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddMXRecord request", ErrInvalidArgument)
+	}
+
+	if err := validateUint16("priority", priority); err != nil {
+		return 0, fmt.Errorf("%w - AddMXRecord request", err)
+	}
+
+	if linkedrecordname == "" {
+		return 0, fmt.Errorf("%w - empty linked record name - AddMXRecord request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"viewId":           {strconv.Itoa(viewid)},
+		"absoluteName":     {absolutename},
+		"priority":         {strconv.Itoa(priority)},
+		"linkedRecordName": {linkedrecordname},
+		"ttl":              {strconv.Itoa(ttl)},
+		"properties":       {properties},
+	}
+	resp, err := b.post(ctx, "addMXRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddMXRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddMXRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddNAPTRRecord adds a naming authority pointer (NAPTR) record.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `absolutename` is the FQDN
+// of the record. If you are adding a record in a zone that is linked to an incremental naming policy, add a single
+// hash sign (#) at the appropriate location in the FQDN.
+//
+// Parameter `order` is the order in which the NAPTR records must be processed, and parameter `preference` is the
+// order in which records with the same order should be processed; both range from 0 to 65535. Parameter `service`
+// is the service, such as "E2U+sip". Parameter `regexp` is the substitution expression applied to the original
+// string, and parameter `replacement` is the next domain name to query; set at most one of them, and use "." for an
+// empty replacement. Parameter `flags` controls the rewriting and interpretation of the fields, and is one of "",
+// "S", "A", "U" or "P".
+//
+// Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set this value to -1. Parameter
+// `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new NAPTR record.
+func (b *Bluecat) AddNAPTRRecord(viewid int, absolutename string, order, preference int, service, regexp, replacement, flags string, ttl int, properties string) (int64, error) {
+	return b.AddNAPTRRecordContext(context.Background(), viewid, absolutename, order, preference, service, regexp, replacement, flags, ttl, properties)
+}
+
+// AddNAPTRRecordContext performs AddNAPTRRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddNAPTRRecordContext(ctx context.Context, viewid int, absolutename string, order, preference int, service, regexp, replacement, flags string, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddNAPTRRecord request", ErrInvalidArgument)
+	}
+
+	if err := validateUint16("order", order); err != nil {
+		return 0, fmt.Errorf("%w - AddNAPTRRecord request", err)
+	}
+
+	if err := validateUint16("preference", preference); err != nil {
+		return 0, fmt.Errorf("%w - AddNAPTRRecord request", err)
+	}
+
+	if regexp != "" && replacement != "" && replacement != "." {
+		return 0, fmt.Errorf("%w - both regexp and replacement set - AddNAPTRRecord request", ErrInvalidArgument)
+	}
+
+	switch strings.ToUpper(flags) {
+	case "", "S", "A", "U", "P":
+	default:
+		return 0, fmt.Errorf("%w - invalid flags %q - AddNAPTRRecord request", ErrInvalidArgument, flags)
+	}
+
+	params := url.Values{
+		"viewId":       {strconv.Itoa(viewid)},
+		"absoluteName": {absolutename},
+		"order":        {strconv.Itoa(order)},
+		"preference":   {strconv.Itoa(preference)},
+		"service":      {service},
+		"regexp":       {regexp},
+		"replacement":  {replacement},
+		"flags":        {flags},
+		"ttl":          {strconv.Itoa(ttl)},
+		"properties":   {properties},
+	}
+	resp, err := b.post(ctx, "addNAPTRRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddNAPTRRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddNAPTRRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddSRVRecord adds a service (SRV) record.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `absolutename` is the FQDN
+// of the record. If you are adding a record in a zone that is linked to an incremental naming policy, add a single
+// hash sign (#) at the appropriate location in the FQDN. The name of an SRV record has the form
+// _service._protocol.name, e.g. "_sip._tcp.example.com".
+//
+// Parameter `priority` is the priority of the target host, where lower values are preferred, and parameter `weight`
+// is the relative weight of targets with the same priority. Parameter `port` is the TCP or UDP port of the service.
+// All three range from 0 to 65535. Parameter `linkedrecordname` is the FQDN of the host record or external host
+// record that provides the service.
+//
+// Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set this value to -1. Parameter
+// `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new SRV record.
+func (b *Bluecat) AddSRVRecord(viewid int, absolutename string, priority, port, weight int, linkedrecordname string, ttl int, properties string) (int64, error) {
+	return b.AddSRVRecordContext(context.Background(), viewid, absolutename, priority, port, weight, linkedrecordname, ttl, properties)
+}
+
+// AddSRVRecordContext performs AddSRVRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddSRVRecordContext(ctx context.Context, viewid int, absolutename string, priority, port, weight int, linkedrecordname string, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddSRVRecord request", ErrInvalidArgument)
+	}
+
+	if err := validateUint16("priority", priority); err != nil {
+		return 0, fmt.Errorf("%w - AddSRVRecord request", err)
+	}
+
+	if err := validateUint16("port", port); err != nil {
+		return 0, fmt.Errorf("%w - AddSRVRecord request", err)
+	}
+
+	if err := validateUint16("weight", weight); err != nil {
+		return 0, fmt.Errorf("%w - AddSRVRecord request", err)
+	}
+
+	if linkedrecordname == "" {
+		return 0, fmt.Errorf("%w - empty linked record name - AddSRVRecord request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"viewId":           {strconv.Itoa(viewid)},
+		"absoluteName":     {absolutename},
+		"priority":         {strconv.Itoa(priority)},
+		"port":             {strconv.Itoa(port)},
+		"weight":           {strconv.Itoa(weight)},
+		"linkedRecordName": {linkedrecordname},
+		"ttl":              {strconv.Itoa(ttl)},
+		"properties":       {properties},
+	}
+	resp, err := b.post(ctx, "addSRVRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddSRVRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddSRVRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddTXTRecord adds a text (TXT) record.
+//
+// Parameter `viewid` is the object ID of the view to which the record is added. Parameter `absolutename` is the FQDN
+// of the record. If you are adding a record in a zone that is linked to an incremental naming policy, add a single
+// hash sign (#) at the appropriate location in the FQDN.
+//
+// Parameter `txt` is the text of the record, without surrounding quotes.
+//
+// Parameter `ttl` is the time-to-live (TTL) value for the record. To ignore the TTL, set this value to -1. Parameter
+// `properties` adds object properties, including comments and user-defined fields.
+//
+// Returns the object ID of the new TXT record.
+func (b *Bluecat) AddTXTRecord(viewid int, absolutename, txt string, ttl int, properties string) (int64, error) {
+	return b.AddTXTRecordContext(context.Background(), viewid, absolutename, txt, ttl, properties)
+}
+
+// AddTXTRecordContext performs AddTXTRecord using ctx to cancel or time out the request.
+func (b *Bluecat) AddTXTRecordContext(ctx context.Context, viewid int, absolutename, txt string, ttl int, properties string) (int64, error) {
+	if absolutename == "" {
+		return 0, fmt.Errorf("%w - empty absolute name - AddTXTRecord request", ErrInvalidArgument)
+	}
+
+	if txt == "" {
+		return 0, fmt.Errorf("%w - empty text - AddTXTRecord request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"viewId":       {strconv.Itoa(viewid)},
+		"absoluteName": {absolutename},
+		"txt":          {txt},
+		"ttl":          {strconv.Itoa(ttl)},
+		"properties":   {properties},
+	}
+	resp, err := b.post(ctx, "addTXTRecord", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddTXTRecord request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddTXTRecord response", err)
+	}
+
+	return id, nil
+}
+
+// AddVendorOptionDefinition defines an option of a vendor profile. The options of a vendor profile are sent to
+// the clients of that vendor encapsulated in option 43, and are added with AddDHCPVendorDeploymentOption.
+//
+// Parameter `vendorprofileid` is the object ID of the vendor profile. Parameter `code` is the code of the option within
+// the vendor profile, from 1 to 254. Parameter `name` is the name of the option, and parameter `description` describes
+// it. Parameter `optiontype` is the type of the value of the option. Parameter `allowmultiple` allows the option to
+// hold a list of values of that type. Parameter `properties` adds object properties, including user-defined fields.
+//
+// Returns the object ID of the new vendor option definition.
+func (b *Bluecat) AddVendorOptionDefinition(vendorprofileid, code int, name string, optiontype OptionValueType, description string, allowmultiple bool, properties string) (int64, error) {
+	return b.AddVendorOptionDefinitionContext(context.Background(), vendorprofileid, code, name, optiontype, description, allowmultiple, properties)
+}
+
+// AddVendorOptionDefinitionContext performs AddVendorOptionDefinition using ctx to cancel or time out the request.
+func (b *Bluecat) AddVendorOptionDefinitionContext(ctx context.Context, vendorprofileid, code int, name string, optiontype OptionValueType, description string, allowmultiple bool, properties string) (int64, error) {
+	if err := validateOptionDefinition(name, code, optiontype); err != nil {
+		return 0, fmt.Errorf("%w - AddVendorOptionDefinition request", err)
+	}
+
+	params := url.Values{
+		"vendorProfileId": {strconv.Itoa(vendorprofileid)},
+		"optionId":        {strconv.Itoa(code)},
+		"name":            {name},
+		"type":            {string(optiontype)},
+		"description":     {description},
+		"allowMultiple":   {strconv.FormatBool(allowmultiple)},
+		"properties":      {properties},
+	}
+	resp, err := b.post(ctx, "addVendorOptionDefinition", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddVendorOptionDefinition request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddVendorOptionDefinition response", err)
+	}
+
+	return id, nil
+}
+
+// AddVendorProfile adds a vendor profile, which groups the vendor specific options of a type of device.
+//
+// Parameter `identifier` is the vendor class identifier sent by the clients in option 60, e.g. "Cisco Systems, Inc.
+// IP Phone". Parameter `name` is the name of the profile, and parameter `description` describes it. Parameter
+// `properties` adds object properties, including user-defined fields.
+//
+// Returns the object ID of the new vendor profile.
+func (b *Bluecat) AddVendorProfile(identifier, name, description, properties string) (int64, error) {
+	return b.AddVendorProfileContext(context.Background(), identifier, name, description, properties)
+}
+
+// AddVendorProfileContext performs AddVendorProfile using ctx to cancel or time out the request.
+func (b *Bluecat) AddVendorProfileContext(ctx context.Context, identifier, name, description, properties string) (int64, error) {
+	if identifier == "" || name == "" {
+		return 0, fmt.Errorf("%w - empty identifier or name - AddVendorProfile request", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"identifier":  {identifier},
+		"name":        {name},
+		"description": {description},
+		"properties":  {properties},
+	}
+	resp, err := b.post(ctx, "addVendorProfile", params)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddVendorProfile request", err)
+	}
+
+	id, err := parseID(resp)
+	if err != nil {
+		return 0, fmt.Errorf("%w - AddVendorProfile response", err)
+	}
+
+	return id, nil
+}
+
+// addDeploymentOption adds a deployment option through the API method `call` and returns its object ID.
+func (b *Bluecat) addDeploymentOption(ctx context.Context, call string, entityid int, name, value, properties string) (int64, error) {
+	if name == "" {
+		return 0, fmt.Errorf("%w - empty option name", ErrInvalidArgument)
+	}
+
+	params := url.Values{
+		"entityId":   {strconv.Itoa(entityid)},
+		"name":       {name},
+		"value":      {value},
+		"properties": {properties},
+	}
+	resp, err := b.post(ctx, call, params)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return parseID(resp)
+}
+
+// addDeploymentRole adds a deployment role through the API method `call` and returns its object ID.
+func (b *Bluecat) addDeploymentRole(ctx context.Context, call string, entityid, serverinterfaceid int, roletype, properties string) (int64, error) {
+	params := url.Values{
+		"entityId":          {strconv.Itoa(entityid)},
+		"serverInterfaceId": {strconv.Itoa(serverinterfaceid)},
+		"type":              {roletype},
+		"properties":        {properties},
+	}
+	resp, err := b.post(ctx, call, params)
 
 	if err != nil {
-		return "", fmt.Errorf("%s - addGenericRecord request", err)
+		return 0, err
+	}
+
+	return parseID(resp)
+}
+
+// validateOptionDefinition returns an error wrapping ErrInvalidArgument if the name, code or type of an option
+// definition is invalid.
+func validateOptionDefinition(name string, code int, optiontype OptionValueType) error {
+	if name == "" {
+		return fmt.Errorf("%w - empty option name", ErrInvalidArgument)
+	}
+
+	if code < 1 || code > 254 {
+		return fmt.Errorf("%w - option code %d is not between 1 and 254", ErrInvalidArgument, code)
+	}
+
+	return optiontype.validate()
+}
+
+// validateAddresses returns an error wrapping ErrInvalidArgument if one of addresses is not an IP address.
+func validateAddresses(addresses []string) error {
+	for _, address := range addresses {
+		if net.ParseIP(address) == nil {
+			return fmt.Errorf("%w - invalid IP address %q", ErrInvalidArgument, address)
+		}
+	}
+
+	return nil
+}
+
+// validateUint16 returns an error wrapping ErrInvalidArgument if v, the value of the parameter `name`, is not between
+// 0 and 65535.
+func validateUint16(name string, v int) error {
+	if v < 0 || v > 65535 {
+		return fmt.Errorf("%w - %s %d is not between 0 and 65535", ErrInvalidArgument, name, v)
+	}
+
+	return nil
+}
+
+// validateDigits returns an error wrapping ErrInvalidArgument if v, the value of the parameter `name`, is empty or
+// contains anything other than the digits 0 to 9.
+func validateDigits(name, v string) error {
+	if v == "" {
+		return fmt.Errorf("%w - empty %s", ErrInvalidArgument, name)
 	}
 
-	if strings.Contains(resp.String(), "Invalid") {
-		return "", fmt.Errorf("%s - addGenericRecord response", resp.String())
+	for _, r := range v {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("%w - %s %q contains a non-digit", ErrInvalidArgument, name, v)
+		}
 	}
 
-	return resp.String(), nil
+	return nil
 }
@@ -0,0 +1,197 @@
+package bluecat
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Properties holds the name-value pairs of an Address Manager properties string, such as
+// "comments=core switch|locationCode=US NYC|". Use ParseProperties to read the Properties field of the API types,
+// and Encode to build the `properties` argument of any method that accepts one:
+//
+//	props := bluecat.Properties{"comments": "added by automation", "ttl": "3600"}
+//	properties, err := props.Encode()
+//	if err != nil {
+//		return err
+//	}
+//	id, err := bc.AddGenericRecord(name, properties, rdata, -1, "TXT", viewid)
+//
+// Names and values are kept verbatim, as Address Manager has no escape syntax for properties strings: a name cannot
+// contain '|' or '=', and a value cannot contain '|'. Encode returns an error for properties that break these rules.
+type Properties map[string]string
+
+// ParseProperties parses a pipe-delimited properties string into Properties. Each pair is split at its first '=', so
+// a value may contain '='. Empty pairs are skipped, and a pair without an '=' is kept with an empty value.
+func ParseProperties(s string) Properties {
+	props := Properties{}
+	for _, pair := range strings.Split(s, "|") {
+		if pair == "" {
+			continue
+		}
+
+		name, value := pair, ""
+		if i := strings.Index(pair, "="); i >= 0 {
+			name, value = pair[:i], pair[i+1:]
+		}
+
+		props[name] = value
+	}
+
+	return props
+}
+
+// Encode returns the properties as a pipe-delimited string with a trailing '|', with the names in sorted order. The
+// names and values are written as they are, see Properties. Encode returns an error wrapping ErrInvalidArgument if a
+// name is empty or contains '|' or '=', or if a value contains '|', as the string would not parse back to p.
+func (p Properties) Encode() (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	return p.encode(), nil
+}
+
+// validate returns an error wrapping ErrInvalidArgument if p cannot be written as a properties string.
+func (p Properties) validate() error {
+	for name, value := range p {
+		if name == "" || strings.ContainsAny(name, "|=") {
+			return fmt.Errorf("%w - invalid property name %q", ErrInvalidArgument, name)
+		}
+
+		if strings.Contains(value, "|") {
+			return fmt.Errorf("%w - property %s: value %q contains '|'", ErrInvalidArgument, name, value)
+		}
+	}
+
+	return nil
+}
+
+// encode writes p as a properties string without checking it.
+func (p Properties) encode() string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(name)
+		sb.WriteByte('=')
+		sb.WriteString(p[name])
+		sb.WriteByte('|')
+	}
+
+	return sb.String()
+}
+
+// String implements fmt.Stringer. It writes the properties like Encode, but does not check them, so use it only to
+// print properties.
+func (p Properties) String() string {
+	return p.encode()
+}
+
+// Get returns the value of the property `name`, or an empty string if it is not set.
+func (p Properties) Get(name string) string {
+	return p[name]
+}
+
+// Set sets the property `name` to value.
+func (p Properties) Set(name, value string) {
+	p[name] = value
+}
+
+// Del removes the property `name`.
+func (p Properties) Del(name string) {
+	delete(p, name)
+}
+
+// PropertyMap returns the parsed Properties field of the entity.
+func (e APIEntity) PropertyMap() Properties {
+	return ParseProperties(e.Properties)
+}
+
+// PropertyMap returns the parsed Properties field of the access right.
+func (a APIAccessRight) PropertyMap() Properties {
+	return ParseProperties(a.Properties)
+}
+
+// PropertyMap returns the parsed Properties field of the data.
+func (d APIData) PropertyMap() Properties {
+	return ParseProperties(d.Properties)
+}
+
+// PropertyMap returns the parsed Properties field of the deployment option.
+func (o APIDeploymentOption) PropertyMap() Properties {
+	return ParseProperties(o.Properties)
+}
+
+// PropertyMap returns the parsed Properties field of the deployment role.
+func (r APIDeploymentRole) PropertyMap() Properties {
+	return ParseProperties(r.Properties)
+}
+
+// PropertyMap returns the parsed Properties field of the user-defined field.
+func (f APIUserDefinedField) PropertyMap() Properties {
+	return ParseProperties(f.Properties)
+}
+
+// AccessOverrides maps object types to the access right that applies to objects of that type instead of the default
+// access right of an access right, e.g. AccessOverrides{ObjectTypeHostRecord: AccessChange}.
+type AccessOverrides map[ObjectType]AccessRight
+
+// ParseAccessOverrides parses an overrides string such as "HostRecord=CHANGE|View=VIEW|" into AccessOverrides.
+func ParseAccessOverrides(s string) AccessOverrides {
+	overrides := AccessOverrides{}
+	for name, value := range ParseProperties(s) {
+		overrides[ObjectType(name)] = AccessRight(value)
+	}
+
+	return overrides
+}
+
+// Encode returns the overrides as a pipe-delimited string with a trailing '|', in the format of Encode on Properties.
+func (o AccessOverrides) Encode() string {
+	return o.properties().encode()
+}
+
+// properties returns o as Properties.
+func (o AccessOverrides) properties() Properties {
+	props := make(Properties, len(o))
+	for t, a := range o {
+		props[string(t)] = string(a)
+	}
+
+	return props
+}
+
+// validate returns an error wrapping ErrInvalidArgument if o holds an invalid object type or an unknown access right.
+func (o AccessOverrides) validate() error {
+	for t, a := range o {
+		if err := t.validate(); err != nil {
+			return err
+		}
+
+		if err := a.validate(); err != nil {
+			return err
+		}
+	}
+
+	return o.properties().validate()
+}
+
+// OverrideMap returns the parsed Overrides field of the access right.
+func (a APIAccessRight) OverrideMap() AccessOverrides {
+	return ParseAccessOverrides(a.Overrides)
+}
+
+// appendProperty returns the properties string `properties` with the pair name=value added at its end. The rest of
+// the string is left exactly as the caller wrote it.
+func appendProperty(properties, name, value string) string {
+	if properties != "" && !strings.HasSuffix(properties, "|") {
+		properties += "|"
+	}
+
+	return properties + name + "=" + value + "|"
+}
@@ -0,0 +1,119 @@
+package bluecat
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Properties
+	}{
+		{name: "empty", in: "", want: Properties{}},
+		{name: "pairs", in: "comments=core switch|locationCode=US NYC|", want: Properties{"comments": "core switch", "locationCode": "US NYC"}},
+		{name: "no trailing pipe", in: "a=1|b=2", want: Properties{"a": "1", "b": "2"}},
+		{name: "empty pairs", in: "|a=1||", want: Properties{"a": "1"}},
+		{name: "no value", in: "flag|a=", want: Properties{"flag": "", "a": ""}},
+		{name: "equals in value", in: "customMatchRequest=substring(option vendor-class-identifier,0,9)=\"PXEClient\"|", want: Properties{"customMatchRequest": "substring(option vendor-class-identifier,0,9)=\"PXEClient\""}},
+		{name: "backslashes", in: `comments=C:\temp\new|path=\\server\share\|`, want: Properties{"comments": `C:\temp\new`, "path": `\\server\share\`}},
+		{name: "last one wins", in: "a=1|a=2|", want: Properties{"a": "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseProperties(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseProperties(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPropertiesEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      Properties
+		want    string
+		wantErr error
+	}{
+		{name: "empty", in: Properties{}, want: ""},
+		{name: "sorted", in: Properties{"ttl": "3600", "comments": "added by automation"}, want: "comments=added by automation|ttl=3600|"},
+		{name: "equals in value", in: Properties{"x": "a=b"}, want: "x=a=b|"},
+		{name: "backslashes", in: Properties{"comments": `C:\temp\new`}, want: `comments=C:\temp\new|`},
+		{name: "empty value", in: Properties{"a": ""}, want: "a=|"},
+		{name: "pipe in value", in: Properties{"comments": "x|locked=true"}, wantErr: ErrInvalidArgument},
+		{name: "pipe in name", in: Properties{"a|b": "1"}, wantErr: ErrInvalidArgument},
+		{name: "equals in name", in: Properties{"a=b": "1"}, wantErr: ErrInvalidArgument},
+		{name: "empty name", in: Properties{"": "1"}, wantErr: ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.in.Encode()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Encode() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if back := ParseProperties(got); !reflect.DeepEqual(back, tt.in) {
+				t.Errorf("ParseProperties(%q) = %#v, want %#v", got, back, tt.in)
+			}
+		})
+	}
+}
+
+func TestAccessOverrides(t *testing.T) {
+	in := "HostRecord=CHANGE|View=VIEW|"
+	want := AccessOverrides{ObjectTypeHostRecord: AccessChange, ObjectTypeView: AccessView}
+
+	got := ParseAccessOverrides(in)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseAccessOverrides(%q) = %#v, want %#v", in, got, want)
+	}
+
+	if s := got.Encode(); s != in {
+		t.Errorf("Encode() = %q, want %q", s, in)
+	}
+
+	if err := got.validate(); err != nil {
+		t.Errorf("validate() = %v, want nil", err)
+	}
+
+	if err := (AccessOverrides{"TACACS": AccessView}).validate(); err != nil {
+		t.Errorf("validate() of an object type without a constant = %v, want nil", err)
+	}
+
+	if err := (AccessOverrides{ObjectTypeView: "Bogus"}).validate(); err == nil {
+		t.Error("validate() of an unknown access right = nil, want an error")
+	}
+
+	if err := (AccessOverrides{"Host|Record": AccessChange}).validate(); err == nil {
+		t.Error("validate() of an object type containing '|' = nil, want an error")
+	}
+}
+
+func TestAppendProperty(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "", want: "reverseRecord=true|"},
+		{in: `comments=C:\temp|`, want: `comments=C:\temp|reverseRecord=true|`},
+		{in: "comments=a=b", want: "comments=a=b|reverseRecord=true|"},
+	}
+
+	for _, tt := range tests {
+		if got := appendProperty(tt.in, "reverseRecord", "true"); got != tt.want {
+			t.Errorf("appendProperty(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,530 @@
+package bluecat
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ResizeRange changes the start and end address of an IPv4 DHCP range.
+//
+// Parameter `rangeid` is the object ID of the DHCP range. Parameters `start` and `end` are the new first and last
+// IPv4 address of the range. Parameter `options` is a string containing options such as
+// "convertOrphanedIPAddressesTo=UNALLOCATED|", which sets the state of the addresses that are left outside of the
+// range.
+//
+// Before the request is sent, the new range is checked against the bounds returned by GetMaxAllowedRange. An error
+// wrapping ErrInvalidArgument is returned if it exceeds them.
+func (b *Bluecat) ResizeRange(rangeid int, start, end, options string) error {
+	return b.ResizeRangeContext(context.Background(), rangeid, start, end, options)
+}
+
+// ResizeRangeContext performs ResizeRange using ctx to cancel or time out the request.
+func (b *Bluecat) ResizeRangeContext(ctx context.Context, rangeid int, start, end, options string) error {
+	first, last, err := parseIP4Range(start, end)
+	if err != nil {
+		return fmt.Errorf("%w - ResizeRange request", err)
+	}
+
+	entity, err := b.GetEntityByIDContext(ctx, rangeid)
+	if err != nil {
+		return fmt.Errorf("%w - ResizeRange request", err)
+	}
+
+	if err := checkType(&entity, ObjectTypeDHCP4Range); err != nil {
+		return fmt.Errorf("%w - ResizeRange request", err)
+	}
+
+	lo, hi, err := b.maxAllowedRange(ctx, rangeid)
+	if err != nil {
+		return fmt.Errorf("%w - ResizeRange request", err)
+	}
+
+	if first < lo || last > hi {
+		return fmt.Errorf("%w - range %s-%s exceeds the maximum allowed range %s-%s - ResizeRange request",
+			ErrInvalidArgument, start, end, formatIP4(lo), formatIP4(hi))
+	}
+
+	params := url.Values{
+		"objectId": {strconv.Itoa(rangeid)},
+		"range":    {start + "-" + end},
+		"options":  {options},
+	}
+
+	if _, err := b.put(ctx, "resizeRange", params); err != nil {
+		return fmt.Errorf("%w - ResizeRange request", err)
+	}
+
+	return nil
+}
+
+// Update updates an object. The ID and Type fields of entity identify the object to update, and its Name and
+// Properties fields replace the name and the properties of the object. Read the object with GetEntityByID, change
+// it and pass it to Update, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) Update(entity APIEntity) error {
+	return b.UpdateContext(context.Background(), entity)
+}
+
+// UpdateContext performs Update using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateContext(ctx context.Context, entity APIEntity) error {
+	if entity.ID == 0 {
+		return fmt.Errorf("%w - missing object ID - Update request", ErrInvalidArgument)
+	}
+
+	if err := ObjectType(entity.Type).validate(); err != nil {
+		return fmt.Errorf("%w - Update request", err)
+	}
+
+	if _, err := b.putJSON(ctx, "update", nil, entity); err != nil {
+		return fmt.Errorf("%w - Update request", err)
+	}
+
+	return nil
+}
+
+// UpdateAccessRight updates an access right for an object.
+//
+// Parameter `entityid` is the object ID of the object to which the access right is assigned, or 0 for a system-level
+// access right. Parameter `userid` is the object ID of the user or group to which the access right applies.
+// Parameter `value` is the new default access right for the object.
+//
+// Parameter `overrides` replaces the access rights set for specific object types below the object, and can be nil to
+// remove them. Parameter `properties` replaces the properties of the access right.
+func (b *Bluecat) UpdateAccessRight(entityid, userid int, value AccessRight, overrides AccessOverrides, properties string) error {
+	return b.UpdateAccessRightContext(context.Background(), entityid, userid, value, overrides, properties)
+}
+
+// UpdateAccessRightContext performs UpdateAccessRight using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateAccessRightContext(ctx context.Context, entityid, userid int, value AccessRight, overrides AccessOverrides, properties string) error {
+	if err := value.validate(); err != nil {
+		return fmt.Errorf("%w - UpdateAccessRight request", err)
+	}
+
+	if err := overrides.validate(); err != nil {
+		return fmt.Errorf("%w - UpdateAccessRight request", err)
+	}
+
+	params := url.Values{
+		"entityId":   {strconv.Itoa(entityid)},
+		"userId":     {strconv.Itoa(userid)},
+		"value":      {string(value)},
+		"overrides":  {overrides.Encode()},
+		"properties": {properties},
+	}
+
+	if _, err := b.put(ctx, "updateAccessRight", params); err != nil {
+		return fmt.Errorf("%w - UpdateAccessRight request", err)
+	}
+
+	return nil
+}
+
+// UpdateCustomOptionDefinition updates a custom option definition. The ID of entity identifies the custom option
+// definition, and its Name and Properties fields replace the ones of the custom option definition. Read the custom
+// option definition with GetCustomOptionDefinitions, change it and pass it to UpdateCustomOptionDefinition, so that the
+// properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateCustomOptionDefinition(entity APIEntity) error {
+	return b.UpdateCustomOptionDefinitionContext(context.Background(), entity)
+}
+
+// UpdateCustomOptionDefinitionContext performs UpdateCustomOptionDefinition using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateCustomOptionDefinitionContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeCustomOptionDef); err != nil {
+		return fmt.Errorf("%w - UpdateCustomOptionDefinition request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateCustomOptionDefinition", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCP6ClientDeploymentOption updates a DHCPv6 client deployment option. The ID of option identifies the option,
+// and its Value and Properties fields replace the ones of the option. Read the option with
+// GetDHCP6ClientDeploymentOption, change it and pass it to UpdateDHCP6ClientDeploymentOption.
+func (b *Bluecat) UpdateDHCP6ClientDeploymentOption(option APIDeploymentOption) error {
+	return b.UpdateDHCP6ClientDeploymentOptionContext(context.Background(), option)
+}
+
+// UpdateDHCP6ClientDeploymentOptionContext performs UpdateDHCP6ClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCP6ClientDeploymentOptionContext(ctx context.Context, option APIDeploymentOption) error {
+	if err := b.updateDeploymentOption(ctx, "updateDHCP6ClientDeploymentOption", option, OptionTypeDHCPV6ClientOption); err != nil {
+		return fmt.Errorf("%w - UpdateDHCP6ClientDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCP6Range updates an IPv6 DHCP range. The ID of entity identifies the range, and its Name and Properties
+// fields replace the ones of the range. Read the range with GetEntityByID or GetIPRangeByIP, change it and pass it to
+// UpdateDHCP6Range, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateDHCP6Range(entity APIEntity) error {
+	return b.UpdateDHCP6RangeContext(context.Background(), entity)
+}
+
+// UpdateDHCP6RangeContext performs UpdateDHCP6Range using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCP6RangeContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeDHCP6Range); err != nil {
+		return fmt.Errorf("%w - UpdateDHCP6Range request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateDHCP6Range", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCP6ServiceDeploymentOption updates a DHCPv6 service deployment option. The ID of option identifies the
+// option, and its Value and Properties fields replace the ones of the option. Read the option with
+// GetDHCP6ServiceDeploymentOption, change it and pass it to UpdateDHCP6ServiceDeploymentOption.
+func (b *Bluecat) UpdateDHCP6ServiceDeploymentOption(option APIDeploymentOption) error {
+	return b.UpdateDHCP6ServiceDeploymentOptionContext(context.Background(), option)
+}
+
+// UpdateDHCP6ServiceDeploymentOptionContext performs UpdateDHCP6ServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCP6ServiceDeploymentOptionContext(ctx context.Context, option APIDeploymentOption) error {
+	if err := b.updateDeploymentOption(ctx, "updateDHCP6ServiceDeploymentOption", option, OptionTypeDHCPV6ServiceOption); err != nil {
+		return fmt.Errorf("%w - UpdateDHCP6ServiceDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCPClientDeploymentOption updates a DHCPv4 client deployment option. The ID of option identifies the option,
+// and its Value and Properties fields replace the ones of the option. Read the option with
+// GetDHCPClientDeploymentOption, change it and pass it to UpdateDHCPClientDeploymentOption.
+func (b *Bluecat) UpdateDHCPClientDeploymentOption(option APIDeploymentOption) error {
+	return b.UpdateDHCPClientDeploymentOptionContext(context.Background(), option)
+}
+
+// UpdateDHCPClientDeploymentOptionContext performs UpdateDHCPClientDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCPClientDeploymentOptionContext(ctx context.Context, option APIDeploymentOption) error {
+	if err := b.updateDeploymentOption(ctx, "updateDHCPClientDeploymentOption", option, OptionTypeDHCPV4ClientOption); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPClientDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCPDeploymentRole updates a DHCP deployment role. The ID of role identifies the role, and its Type and
+// Properties fields replace the ones of the role. Read the role with GetDHCPDeploymentRole, change it and pass it to
+// UpdateDHCPDeploymentRole.
+func (b *Bluecat) UpdateDHCPDeploymentRole(role APIDeploymentRole) error {
+	return b.UpdateDHCPDeploymentRoleContext(context.Background(), role)
+}
+
+// UpdateDHCPDeploymentRoleContext performs UpdateDHCPDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCPDeploymentRoleContext(ctx context.Context, role APIDeploymentRole) error {
+	if err := DHCPRoleType(role.Type).validate(); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPDeploymentRole request", err)
+	}
+
+	if err := b.updateDeploymentRole(ctx, "updateDHCPDeploymentRole", role, "DHCP"); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPDeploymentRole request", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCPMatchClass updates a DHCP match class. The ID of entity identifies the DHCP match class, and its Name and
+// Properties fields replace the ones of the DHCP match class. Read the DHCP match class with GetDHCPMatchClass, change
+// it and pass it to UpdateDHCPMatchClass, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateDHCPMatchClass(entity APIEntity) error {
+	return b.UpdateDHCPMatchClassContext(context.Background(), entity)
+}
+
+// UpdateDHCPMatchClassContext performs UpdateDHCPMatchClass using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCPMatchClassContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeDHCPMatchClass); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPMatchClass request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPMatchClass", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCPServiceDeploymentOption updates a DHCPv4 service deployment option. The ID of option identifies the option,
+// and its Value and Properties fields replace the ones of the option. Read the option with
+// GetDHCPServiceDeploymentOption, change it and pass it to UpdateDHCPServiceDeploymentOption.
+func (b *Bluecat) UpdateDHCPServiceDeploymentOption(option APIDeploymentOption) error {
+	return b.UpdateDHCPServiceDeploymentOptionContext(context.Background(), option)
+}
+
+// UpdateDHCPServiceDeploymentOptionContext performs UpdateDHCPServiceDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCPServiceDeploymentOptionContext(ctx context.Context, option APIDeploymentOption) error {
+	if err := b.updateDeploymentOption(ctx, "updateDHCPServiceDeploymentOption", option, OptionTypeDHCPServiceOption); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPServiceDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCPSubClass updates a DHCP sub-class. The ID of entity identifies the DHCP sub-class, and its Name and
+// Properties fields replace the ones of the DHCP sub-class. Read the DHCP sub-class with GetDHCPSubClasses, change it
+// and pass it to UpdateDHCPSubClass, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateDHCPSubClass(entity APIEntity) error {
+	return b.UpdateDHCPSubClassContext(context.Background(), entity)
+}
+
+// UpdateDHCPSubClassContext performs UpdateDHCPSubClass using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCPSubClassContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeDHCPSubClass); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPSubClass request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPSubClass", err)
+	}
+
+	return nil
+}
+
+// UpdateDHCPVendorDeploymentOption updates a DHCP vendor deployment option. The ID of option identifies the option, and
+// its Value and Properties fields replace the ones of the option. Read the option with GetDHCPVendorDeploymentOption,
+// change it and pass it to UpdateDHCPVendorDeploymentOption.
+func (b *Bluecat) UpdateDHCPVendorDeploymentOption(option APIDeploymentOption) error {
+	return b.UpdateDHCPVendorDeploymentOptionContext(context.Background(), option)
+}
+
+// UpdateDHCPVendorDeploymentOptionContext performs UpdateDHCPVendorDeploymentOption using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDHCPVendorDeploymentOptionContext(ctx context.Context, option APIDeploymentOption) error {
+	if err := b.updateDeploymentOption(ctx, "updateDHCPVendorDeploymentOption", option, OptionTypeVendorClientOption); err != nil {
+		return fmt.Errorf("%w - UpdateDHCPVendorDeploymentOption request", err)
+	}
+
+	return nil
+}
+
+// UpdateDNSDeploymentRole updates a DNS deployment role. The ID of role identifies the role, and its Type and
+// Properties fields replace the ones of the role. Read the role with GetDNSDeploymentRole, change it and pass it to
+// UpdateDNSDeploymentRole.
+func (b *Bluecat) UpdateDNSDeploymentRole(role APIDeploymentRole) error {
+	return b.UpdateDNSDeploymentRoleContext(context.Background(), role)
+}
+
+// UpdateDNSDeploymentRoleContext performs UpdateDNSDeploymentRole using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateDNSDeploymentRoleContext(ctx context.Context, role APIDeploymentRole) error {
+	if err := DNSRoleType(role.Type).validate(); err != nil {
+		return fmt.Errorf("%w - UpdateDNSDeploymentRole request", err)
+	}
+
+	if err := b.updateDeploymentRole(ctx, "updateDNSDeploymentRole", role, "DNS"); err != nil {
+		return fmt.Errorf("%w - UpdateDNSDeploymentRole request", err)
+	}
+
+	return nil
+}
+
+// UpdateEnumNumber updates an ENUM number. The ID of entity identifies the ENUM number, and its Name and Properties
+// fields replace the ones of the ENUM number. Read the ENUM number with GetEnumNumber or GetEntityByID, change it and
+// pass it to UpdateEnumNumber, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateEnumNumber(entity APIEntity) error {
+	return b.UpdateEnumNumberContext(context.Background(), entity)
+}
+
+// UpdateEnumNumberContext performs UpdateEnumNumber using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateEnumNumberContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeEnumNumber); err != nil {
+		return fmt.Errorf("%w - UpdateEnumNumber request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateEnumNumber", err)
+	}
+
+	return nil
+}
+
+// UpdateEnumZone updates an ENUM zone. The ID of entity identifies the ENUM zone, and its Name and Properties fields
+// replace the ones of the ENUM zone. Read the ENUM zone with GetEnumZone or GetEntityByID, change it and pass it to
+// UpdateEnumZone, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateEnumZone(entity APIEntity) error {
+	return b.UpdateEnumZoneContext(context.Background(), entity)
+}
+
+// UpdateEnumZoneContext performs UpdateEnumZone using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateEnumZoneContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeEnumZone); err != nil {
+		return fmt.Errorf("%w - UpdateEnumZone request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateEnumZone", err)
+	}
+
+	return nil
+}
+
+// UpdateExternalHostRecord updates an external host record. The ID of entity identifies the external host record, and
+// its Name and Properties fields replace the ones of the external host record. Read the external host record with
+// GetExternalHostRecord or GetEntityByID, change it and pass it to UpdateExternalHostRecord, so that the properties
+// that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateExternalHostRecord(entity APIEntity) error {
+	return b.UpdateExternalHostRecordContext(context.Background(), entity)
+}
+
+// UpdateExternalHostRecordContext performs UpdateExternalHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateExternalHostRecordContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeExternalHostRecord); err != nil {
+		return fmt.Errorf("%w - UpdateExternalHostRecord request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateExternalHostRecord", err)
+	}
+
+	return nil
+}
+
+// UpdateHostRecord updates a host record. The ID of record identifies the host record, and its Name, Addresses,
+// ReverseRecord, TTL and Properties fields replace the ones of the host record. A TTL of -1 leaves the TTL unset, so
+// that the zone default is used.
+//
+// Read the host record with GetEntityByID or GetHostRecordsByHint and convert it with AsHostRecord, so that the
+// properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateHostRecord(record HostRecord) error {
+	return b.UpdateHostRecordContext(context.Background(), record)
+}
+
+// UpdateHostRecordContext performs UpdateHostRecord using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateHostRecordContext(ctx context.Context, record HostRecord) error {
+	if len(record.Addresses) == 0 {
+		return fmt.Errorf("%w - no addresses - UpdateHostRecord request", ErrInvalidArgument)
+	}
+
+	if err := validateAddresses(record.Addresses); err != nil {
+		return fmt.Errorf("%w - UpdateHostRecord request", err)
+	}
+
+	props := Properties{}
+	for name, value := range record.Properties {
+		props.Set(name, value)
+	}
+	props.Del("absoluteName")
+	props.Set("addresses", strings.Join(record.Addresses, ","))
+	props.Set("reverseRecord", strconv.FormatBool(record.ReverseRecord))
+	if record.TTL >= 0 {
+		props.Set("ttl", strconv.FormatInt(record.TTL, 10))
+	} else {
+		props.Del("ttl")
+	}
+
+	properties, err := props.Encode()
+	if err != nil {
+		return fmt.Errorf("%w - UpdateHostRecord request", err)
+	}
+
+	entity := APIEntity{
+		ID:         record.ID,
+		Name:       record.Name,
+		Type:       string(ObjectTypeHostRecord),
+		Properties: properties,
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateHostRecord", err)
+	}
+
+	return nil
+}
+
+// UpdateVendorOptionDefinition updates a vendor option definition. The ID of entity identifies the vendor option
+// definition, and its Name and Properties fields replace the ones of the vendor option definition. Read the vendor
+// option definition with GetVendorOptionDefinitions, change it and pass it to UpdateVendorOptionDefinition, so that the
+// properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateVendorOptionDefinition(entity APIEntity) error {
+	return b.UpdateVendorOptionDefinitionContext(context.Background(), entity)
+}
+
+// UpdateVendorOptionDefinitionContext performs UpdateVendorOptionDefinition using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateVendorOptionDefinitionContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeVendorOptionDef); err != nil {
+		return fmt.Errorf("%w - UpdateVendorOptionDefinition request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateVendorOptionDefinition", err)
+	}
+
+	return nil
+}
+
+// UpdateVendorProfile updates a vendor profile. The ID of entity identifies the vendor profile, and its Name and
+// Properties fields replace the ones of the vendor profile. Read the vendor profile with GetVendorProfiles, change it
+// and pass it to UpdateVendorProfile, so that the properties that are left unchanged are sent back as they were.
+func (b *Bluecat) UpdateVendorProfile(entity APIEntity) error {
+	return b.UpdateVendorProfileContext(context.Background(), entity)
+}
+
+// UpdateVendorProfileContext performs UpdateVendorProfile using ctx to cancel or time out the request.
+func (b *Bluecat) UpdateVendorProfileContext(ctx context.Context, entity APIEntity) error {
+	if err := checkType(&entity, ObjectTypeVendorProfile); err != nil {
+		return fmt.Errorf("%w - UpdateVendorProfile request", err)
+	}
+
+	if err := b.UpdateContext(ctx, entity); err != nil {
+		return fmt.Errorf("%w - UpdateVendorProfile", err)
+	}
+
+	return nil
+}
+
+// updateDeploymentOption updates a deployment option of the type `optiontype` through the API method `call`. An
+// empty Type of option is set to `optiontype`.
+func (b *Bluecat) updateDeploymentOption(ctx context.Context, call string, option APIDeploymentOption, optiontype OptionType) error {
+	if option.ID == 0 {
+		return fmt.Errorf("%w - missing option ID", ErrInvalidArgument)
+	}
+
+	if option.Type == "" {
+		option.Type = string(optiontype)
+	}
+
+	if OptionType(option.Type) != optiontype {
+		return fmt.Errorf("%w - option %d is of type %s, not %s", ErrInvalidArgument, option.ID, option.Type, optiontype)
+	}
+
+	_, err := b.putJSON(ctx, call, nil, option)
+
+	return err
+}
+
+// updateDeploymentRole updates a deployment role of the service `service` through the API method `call`.
+func (b *Bluecat) updateDeploymentRole(ctx context.Context, call string, role APIDeploymentRole, service string) error {
+	if role.ID == 0 {
+		return fmt.Errorf("%w - missing role ID", ErrInvalidArgument)
+	}
+
+	if role.Service == "" {
+		role.Service = service
+	}
+
+	if role.Service != service {
+		return fmt.Errorf("%w - role %d is a %s role, not a %s role", ErrInvalidArgument, role.ID, role.Service, service)
+	}
+
+	_, err := b.putJSON(ctx, call, nil, role)
+
+	return err
+}
+
+// checkType returns an error wrapping ErrInvalidArgument if entity is not of the type `objecttype`. An empty Type is
+// set to `objecttype`.
+func checkType(entity *APIEntity, objecttype ObjectType) error {
+	if entity.Type == "" {
+		entity.Type = string(objecttype)
+	}
+
+	if ObjectType(entity.Type) != objecttype {
+		return fmt.Errorf("%w - object %d is of type %s, not %s", ErrInvalidArgument, entity.ID, entity.Type, objecttype)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package bluecat
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+)
+
+// parseIP4 returns the IPv4 address s as an integer.
+func parseIP4(s string) (uint32, error) {
+	ip := net.ParseIP(s)
+	if ip == nil || ip.To4() == nil {
+		return 0, fmt.Errorf("%w - invalid IPv4 address %q", ErrInvalidArgument, s)
+	}
+
+	return binary.BigEndian.Uint32(ip.To4()), nil
+}
+
+// ip4 returns the IPv4 address v as a net.IP.
+func ip4(v uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, v)
+
+	return ip
+}
+
+// formatIP4 returns the IPv4 address v in dotted decimal notation.
+func formatIP4(v uint32) string {
+	return ip4(v).String()
+}
+
+// parseIP4Range returns the IPv4 addresses start and end as integers, checking that start is not after end.
+func parseIP4Range(start, end string) (uint32, uint32, error) {
+	first, err := parseIP4(start)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	last, err := parseIP4(end)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if first > last {
+		return 0, 0, fmt.Errorf("%w - start address %s is after end address %s", ErrInvalidArgument, start, end)
+	}
+
+	return first, last, nil
+}
+
+// networkBounds returns the first and the last address of the IPv4 network `networkid`.
+func (b *Bluecat) networkBounds(ctx context.Context, networkid int) (uint32, uint32, error) {
+	entity, err := b.GetEntityByIDContext(ctx, networkid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	network, err := entity.AsIP4Network()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w - %s", ErrInvalidArgument, err)
+	}
+
+	_, ipnet, err := net.ParseCIDR(network.CIDR)
+	if err != nil || ipnet.IP.To4() == nil {
+		return 0, 0, fmt.Errorf("invalid CIDR %q of network %d", network.CIDR, networkid)
+	}
+
+	ones, bits := ipnet.Mask.Size()
+	first := binary.BigEndian.Uint32(ipnet.IP.To4())
+	last := uint32(uint64(first) + uint64(1)<<uint(bits-ones) - 1)
+
+	return first, last, nil
+}
+
+// checkDHCP4Range checks that the DHCP range from first to last lies within the bounds lo to hi of the IPv4 network
+// `networkid`, as returned by networkBounds, and that it does not overlap another DHCP range of the network.
+func (b *Bluecat) checkDHCP4Range(ctx context.Context, networkid int, lo, hi, first, last uint32) error {
+	if first < lo || last > hi {
+		return fmt.Errorf("%w - range %s-%s is not within network %s-%s", ErrInvalidArgument,
+			formatIP4(first), formatIP4(last), formatIP4(lo), formatIP4(hi))
+	}
+
+	return b.checkRangeFree(ctx, networkid, ObjectTypeDHCP4Range, ip4(first), ip4(last))
+}
+
+// parseIP6 returns the IPv6 address s.
+func parseIP6(s string) (net.IP, error) {
+	ip := net.ParseIP(s)
+	if ip == nil || ip.To4() != nil {
+		return nil, fmt.Errorf("%w - invalid IPv6 address %q", ErrInvalidArgument, s)
+	}
+
+	return ip, nil
+}
+
+// parseIP6Range returns the IPv6 addresses start and end, checking that start is not after end.
+func parseIP6Range(start, end string) (net.IP, net.IP, error) {
+	first, err := parseIP6(start)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	last, err := parseIP6(end)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bytes.Compare(first, last) > 0 {
+		return nil, nil, fmt.Errorf("%w - start address %s is after end address %s", ErrInvalidArgument, start, end)
+	}
+
+	return first, last, nil
+}
+
+// addIP6 returns the IPv6 address n addresses after ip, or an error if that is beyond the last IPv6 address.
+func addIP6(ip net.IP, n uint64) (net.IP, error) {
+	v := new(big.Int).SetBytes(ip.To16())
+	v.Add(v, new(big.Int).SetUint64(n))
+	if v.BitLen() > 8*net.IPv6len {
+		return nil, fmt.Errorf("%w - %s plus %d is not an IPv6 address", ErrInvalidArgument, ip, n)
+	}
+
+	sum := make(net.IP, net.IPv6len)
+	b := v.Bytes()
+	copy(sum[net.IPv6len-len(b):], b)
+
+	return sum, nil
+}
+
+// checkDHCP6Range checks that the DHCP range from first to last lies within the IPv6 network `networkid`, and that
+// it does not overlap another DHCP range of the network.
+func (b *Bluecat) checkDHCP6Range(ctx context.Context, networkid int, first, last net.IP) error {
+	entity, err := b.GetEntityByIDContext(ctx, networkid)
+	if err != nil {
+		return err
+	}
+
+	if err := checkType(&entity, ObjectTypeIP6Network); err != nil {
+		return err
+	}
+
+	prefix := entity.PropertyMap().Get("prefix")
+	_, ipnet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return fmt.Errorf("invalid prefix %q of network %d", prefix, networkid)
+	}
+
+	if !ipnet.Contains(first) || !ipnet.Contains(last) {
+		return fmt.Errorf("%w - range %s-%s is not within network %s", ErrInvalidArgument, first, last, ipnet)
+	}
+
+	return b.checkRangeFree(ctx, networkid, ObjectTypeDHCP6Range, first, last)
+}
+
+// checkRangeFree checks that the range from first to last does not overlap any DHCP range of the type `objecttype`
+// in the network `networkid`, including ranges that lie entirely within it.
+func (b *Bluecat) checkRangeFree(ctx context.Context, networkid int, objecttype ObjectType, first, last net.IP) error {
+	existing, err := b.allEntities(ctx, networkid, objecttype)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range existing {
+		props := e.PropertyMap()
+		start, end := net.ParseIP(props.Get("start")), net.ParseIP(props.Get("end"))
+		if start == nil || end == nil {
+			return fmt.Errorf("invalid bounds %q-%q of DHCP range %d", props.Get("start"), props.Get("end"), e.ID)
+		}
+
+		if bytes.Compare(first.To16(), end.To16()) <= 0 && bytes.Compare(start.To16(), last.To16()) <= 0 {
+			return fmt.Errorf("%w - range %s-%s overlaps DHCP range %d (%s-%s)", ErrInvalidArgument,
+				first, last, e.ID, start, end)
+		}
+	}
+
+	return nil
+}
+
+// maxAllowedRange returns the first and the last address of the largest range to which the IPv4 DHCP range
+// `rangeid` can be resized, as returned by GetMaxAllowedRange.
+func (b *Bluecat) maxAllowedRange(ctx context.Context, rangeid int) (uint32, uint32, error) {
+	s, err := b.GetMaxAllowedRangeContext(ctx, rangeid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var bounds []string
+	if err := json.Unmarshal([]byte(s), &bounds); err != nil || len(bounds) != 2 {
+		return 0, 0, fmt.Errorf("invalid maximum allowed range %q", s)
+	}
+
+	return parseIP4Range(bounds[0], bounds[1])
+}
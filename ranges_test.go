@@ -0,0 +1,93 @@
+package bluecat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAddDHCP4RangeChecks(t *testing.T) {
+	b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getEntityById"):
+			fmt.Fprint(w, `{"id":5,"name":"net","type":"IP4Network","properties":"CIDR=10.0.0.0/24|"}`)
+		case strings.HasSuffix(r.URL.Path, "/getEntities") && q.Get("start") == "0":
+			fmt.Fprint(w, `[{"id":9,"name":"range","type":"DHCP4Range","properties":"start=10.0.0.50|end=10.0.0.60|"}]`)
+		case strings.HasSuffix(r.URL.Path, "/getEntities"):
+			fmt.Fprint(w, `[]`)
+		default:
+			fmt.Fprint(w, `77`)
+		}
+	})
+	defer ts.Close()
+
+	tests := []struct {
+		start, end string
+		wantErr    error
+	}{
+		{start: "10.0.0.10", end: "10.0.0.20"},
+		{start: "10.0.0.61", end: "10.0.0.255"},
+		{start: "10.0.0.40", end: "10.0.0.70", wantErr: ErrInvalidArgument},
+		{start: "10.0.0.55", end: "10.0.0.56", wantErr: ErrInvalidArgument},
+		{start: "10.0.0.40", end: "10.0.0.50", wantErr: ErrInvalidArgument},
+		{start: "10.0.0.60", end: "10.0.0.70", wantErr: ErrInvalidArgument},
+		{start: "10.0.0.200", end: "10.0.1.10", wantErr: ErrInvalidArgument},
+		{start: "10.0.0.20", end: "10.0.0.10", wantErr: ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		_, err := b.AddDHCP4Range(5, tt.start, tt.end, "")
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("AddDHCP4Range(%s-%s) error = %v, want %v", tt.start, tt.end, err, tt.wantErr)
+		}
+	}
+
+	if n := ts.count("addDHCP4Range"); n != 2 {
+		t.Errorf("addDHCP4Range calls = %d, want 2", n)
+	}
+}
+
+func TestAddDHCP6RangeBySizeChecks(t *testing.T) {
+	b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getEntityById"):
+			fmt.Fprint(w, `{"id":5,"name":"net","type":"IP6Network","properties":"prefix=2001:db8::/120|"}`)
+		case strings.HasSuffix(r.URL.Path, "/getEntities") && q.Get("start") == "0":
+			fmt.Fprint(w, `[{"id":9,"name":"range","type":"DHCP6Range","properties":"start=2001:db8::50|end=2001:db8::60|"}]`)
+		case strings.HasSuffix(r.URL.Path, "/getEntities"):
+			fmt.Fprint(w, `[]`)
+		default:
+			fmt.Fprint(w, `77`)
+		}
+	})
+	defer ts.Close()
+
+	tests := []struct {
+		start   string
+		size    int
+		wantErr error
+	}{
+		{start: "2001:db8::10", size: 16},
+		{start: "2001:db8::61", size: 16},
+		{start: "", size: 16},
+		{start: "2001:db8::40", size: 32, wantErr: ErrInvalidArgument},
+		{start: "2001:db8::f0", size: 32, wantErr: ErrInvalidArgument},
+		{start: "2001:db8::10", size: 0, wantErr: ErrInvalidArgument},
+		{start: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:fff0", size: 32, wantErr: ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		_, err := b.AddDHCP6RangeBySize(5, tt.start, tt.size, "")
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("AddDHCP6RangeBySize(%q, %d) error = %v, want %v", tt.start, tt.size, err, tt.wantErr)
+		}
+	}
+
+	if n := ts.count("addDHCP6RangeBySize"); n != 3 {
+		t.Errorf("addDHCP6RangeBySize calls = %d, want 3", n)
+	}
+}
@@ -0,0 +1,194 @@
+package bluecat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"gopkg.in/resty.v1"
+)
+
+// RetryPolicy controls how requests that fail with a transient error are retried. A request is retried when it
+// times out, has its connection closed or reset by the server or when the server answers with one of the
+// RetryStatusCodes.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request, including the first one. A value of 1 or
+	// less disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the base delay before the first retry. The delay doubles with every attempt.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+
+	// RetryStatusCodes lists the HTTP status codes that are retried.
+	RetryStatusCodes []int
+
+	// RetryNonIdempotent also retries POST requests, which may create an object twice if the first attempt
+	// reached the server. By default only GET, HEAD, OPTIONS, PUT and DELETE requests are retried.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suited for Address Manager servers: up to 4 attempts with exponential
+// backoff between 500ms and 10s, retrying on 429 Too Many Requests and on 502, 503 and 504 responses.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		RetryStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetryPolicy retries requests that fail with a transient error according to p. Requests are not retried
+// unless this option is given.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(o *options) error {
+		o.retry = &p
+
+		return nil
+	}
+}
+
+// WithRateLimit limits the session to `persecond` requests per second on average, allowing bursts of up to
+// `burst` requests. Requests over the limit wait for their turn, or until their context is done.
+func WithRateLimit(persecond float64, burst int) Option {
+	return func(o *options) error {
+		if persecond <= 0 || burst < 1 {
+			return errInvalidRateLimit
+		}
+		o.limiter = newRateLimiter(persecond, burst)
+
+		return nil
+	}
+}
+
+// idempotent reports whether a request with the given HTTP method can safely be sent more than once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryable reports whether the outcome of an attempt should be retried under p. The caller decides whether the
+// request may be retried at all, see RetryNonIdempotent. Of the errors that leave no response, only timeouts and
+// connections closed or reset by the server are retried. Errors such as a failed TLS handshake, an unknown host or a
+// refused connection fail the same way on every attempt and are returned at once.
+func (p *RetryPolicy) retryable(resp *resty.Response, err error) bool {
+	if err != nil {
+		var nerr net.Error
+		if errors.As(err, &nerr) && nerr.Timeout() {
+			return true
+		}
+
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF)
+	}
+
+	for _, code := range p.RetryStatusCodes {
+		if resp.StatusCode() == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the given retry attempt, starting at 1. Full jitter is applied so that many
+// clients retrying at once do not hit the server in lockstep. A Retry-After header sent by the server is honored
+// as long as it does not exceed MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, resp *resty.Response) time.Duration {
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header().Get("Retry-After")); err == nil && secs >= 0 {
+			if d := time.Duration(secs) * time.Second; p.MaxBackoff <= 0 || d <= p.MaxBackoff {
+				return d
+			}
+		}
+	}
+
+	ceiling := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if p.MaxBackoff > 0 && ceiling > float64(p.MaxBackoff) {
+		ceiling = float64(p.MaxBackoff)
+	}
+
+	if ceiling < 1 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(ceiling)))
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket that refills at `rate` tokens per second up to `burst` tokens.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a full token bucket.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, blocking until one is available or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package bluecat
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// testRetryPolicy retries up to 3 attempts without waiting between them.
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:      3,
+	RetryStatusCodes: []int{http.StatusServiceUnavailable},
+}
+
+func TestRetryStatusCodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		policy       RetryPolicy
+		statuses     []int
+		wantErr      bool
+		wantAttempts int
+	}{
+		{name: "success", method: http.MethodGet, policy: testRetryPolicy, statuses: []int{200}, wantAttempts: 1},
+		{name: "recovers", method: http.MethodGet, policy: testRetryPolicy, statuses: []int{503, 503, 200}, wantAttempts: 3},
+		{name: "gives up", method: http.MethodGet, policy: testRetryPolicy, statuses: []int{503, 503, 503, 200}, wantErr: true, wantAttempts: 3},
+		{name: "not retryable", method: http.MethodGet, policy: testRetryPolicy, statuses: []int{400, 200}, wantErr: true, wantAttempts: 1},
+		{name: "post", method: http.MethodPost, policy: testRetryPolicy, statuses: []int{503, 200}, wantErr: true, wantAttempts: 1},
+		{name: "post opted in", method: http.MethodPost, policy: RetryPolicy{MaxAttempts: 3, RetryStatusCodes: []int{503}, RetryNonIdempotent: true}, statuses: []int{503, 200}, wantAttempts: 2},
+		{name: "disabled", method: http.MethodGet, policy: RetryPolicy{MaxAttempts: 1, RetryStatusCodes: []int{503}}, statuses: []int{503, 200}, wantErr: true, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(tt.statuses[attempts-1])
+				fmt.Fprint(w, "1")
+			}, WithRetryPolicy(tt.policy))
+			defer ts.Close()
+
+			_, err := b.do(context.Background(), tt.method, "test", nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	attempts := 0
+	b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprint(w, "1")
+	}, WithRetryPolicy(RetryPolicy{
+		MaxAttempts:      2,
+		MinBackoff:       time.Hour,
+		MaxBackoff:       2 * time.Hour,
+		RetryStatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := b.do(ctx, http.MethodGet, "test", nil, nil); err != nil {
+		t.Fatalf("error = %v, want the retry to follow Retry-After: 0", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 3 * time.Second}
+	for attempt := 1; attempt <= 4; attempt++ {
+		if d := p.backoff(attempt, nil); d < 0 || d > p.MaxBackoff {
+			t.Errorf("backoff(%d) = %s, want between 0 and %s", attempt, d, p.MaxBackoff)
+		}
+	}
+}
+
+// timeoutError is a net.Error that reports a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return false }
+
+// roundTripFunc adapts a function to the http.RoundTripper interface.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+
+	tests := []struct {
+		name          string
+		method        string
+		nonIdempotent bool
+		err           error
+		wantAttempts  int
+	}{
+		{name: "timeout", method: http.MethodGet, err: timeoutError{}, wantAttempts: 3},
+		{name: "timeout post", method: http.MethodPost, err: timeoutError{}, wantAttempts: 1},
+		{name: "timeout post opted in", method: http.MethodPost, nonIdempotent: true, err: timeoutError{}, wantAttempts: 3},
+		{name: "connection reset", method: http.MethodGet, err: reset, wantAttempts: 3},
+		{name: "connection reset post", method: http.MethodPost, err: reset, wantAttempts: 1},
+		{name: "connection reset post opted in", method: http.MethodPost, nonIdempotent: true, err: reset, wantAttempts: 3},
+		{name: "connection refused", method: http.MethodGet, err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, wantAttempts: 1},
+		{name: "no such host", method: http.MethodGet, err: &net.DNSError{Err: "no such host", Name: "bam.invalid", IsNotFound: true}, wantAttempts: 1},
+		{name: "unknown authority", method: http.MethodGet, err: x509.UnknownAuthorityError{}, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(r.URL.Path, "/login") {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{},
+						Body:       ioutil.NopCloser(strings.NewReader("Session Token-> BAMAuthToken: token1= <- for User : admin")),
+						Request:    r,
+					}, nil
+				}
+
+				attempts++
+				return nil, tt.err
+			})
+
+			policy := testRetryPolicy
+			policy.RetryNonIdempotent = tt.nonIdempotent
+			b, err := NewSession("http://bam.example.com", "admin", "secret", WithTransport(transport), WithRetryPolicy(policy))
+			if err != nil {
+				t.Fatalf("NewSession: %v", err)
+			}
+
+			if _, err := b.do(context.Background(), tt.method, "test", nil, nil); err == nil {
+				t.Error("error = nil, want the transport error")
+			}
+
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	l := newRateLimiter(1000, 2)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if d := time.Since(start); d < time.Millisecond {
+		t.Errorf("4 requests with a burst of 2 at 1000/s took %s, want at least 1ms", d)
+	}
+
+	l = newRateLimiter(0.001, 1)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.wait(ctx); !errors.Is(err, ctx.Err()) {
+		t.Errorf("wait with a canceled context = %v, want %v", err, ctx.Err())
+	}
+}
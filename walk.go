@@ -0,0 +1,204 @@
+package bluecat
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// SkipDir can be returned by a WalkFunc to skip the children of the entity it was called for. The walk carries on
+// with the remaining entities.
+var SkipDir = errors.New("skip children")
+
+// WalkFunc is called by Walk for every entity it visits. Parameter `depth` is 0 for the root entity, 1 for its
+// children and so on. Returning SkipDir, or an error wrapping it, skips the children of e, returning ErrStopIteration
+// stops the walk without an error, and any other error stops the walk and is returned by Walk.
+type WalkFunc func(e APIEntity, depth int) error
+
+// DefaultHierarchy lists, for each object type, the child object types that Walk descends into by default:
+// Configuration → IP4Block → IP4Network → IP4Address and DHCP4Range, the IPv6 equivalents, and
+// Configuration → View → Zone → resource records.
+var DefaultHierarchy = map[ObjectType][]ObjectType{
+	ObjectTypeConfiguration: {ObjectTypeIP4Block, ObjectTypeIP6Block, ObjectTypeView},
+	ObjectTypeIP4Block:      {ObjectTypeIP4Block, ObjectTypeIP4Network},
+	ObjectTypeIP4Network:    {ObjectTypeDHCP4Range, ObjectTypeIP4Address},
+	ObjectTypeIP6Block:      {ObjectTypeIP6Block, ObjectTypeIP6Network},
+	ObjectTypeIP6Network:    {ObjectTypeDHCP6Range, ObjectTypeIP6Address},
+	ObjectTypeView:          {ObjectTypeZone, ObjectTypeExternalHostRecord},
+	ObjectTypeZone: {
+		ObjectTypeZone,
+		ObjectTypeHostRecord,
+		ObjectTypeAliasRecord,
+		ObjectTypeMXRecord,
+		ObjectTypeTXTRecord,
+		ObjectTypeSRVRecord,
+		ObjectTypeHINFORecord,
+		ObjectTypeNAPTRRecord,
+		ObjectTypeGenericRecord,
+	},
+}
+
+// WalkOptions controls a Walk.
+type WalkOptions struct {
+	// Types limits the entities passed to the WalkFunc to the given object types. Entities of other types are
+	// still descended into. All entities are passed when Types is empty.
+	Types []ObjectType
+
+	// MaxDepth stops the walk from descending below the given depth, where the root entity is at depth 0. There
+	// is no limit when MaxDepth is zero.
+	MaxDepth int
+
+	// Concurrency is the maximum number of goroutines walking the hierarchy at once, and so of GetEntities calls in
+	// flight. With a value of 1 or less, the walk is sequential and depth-first, and entities are visited in the
+	// order returned by the server. With a higher value the order is unspecified, but calls to the WalkFunc are
+	// still never concurrent.
+	Concurrency int
+
+	// PageSize is the number of children requested per GetEntities call, see PageOptions.
+	PageSize int
+
+	// Hierarchy overrides DefaultHierarchy, the child object types descended into for each object type.
+	Hierarchy map[ObjectType][]ObjectType
+}
+
+// walker holds the state of a Walk.
+type walker struct {
+	b      *Bluecat
+	opts   WalkOptions
+	fn     WalkFunc
+	types  map[ObjectType]bool
+	sem    chan struct{}
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	wg   sync.WaitGroup
+	once sync.Once
+	err  error
+}
+
+// Walk traverses the Address Manager hierarchy below the entity `rootid`, calling fn for the root and for every
+// entity found below it. The children of each entity are listed with GetEntities, for the child object types given
+// by opts.Hierarchy or DefaultHierarchy.
+//
+// If ctx is canceled or its deadline expires before the walk is complete, Walk returns ctx.Err().
+func (b *Bluecat) Walk(ctx context.Context, rootid int, opts WalkOptions, fn WalkFunc) error {
+	root, err := b.GetEntityByIDContext(ctx, rootid)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := &walker{
+		b:      b,
+		opts:   opts,
+		fn:     fn,
+		cancel: cancel,
+	}
+
+	if w.opts.Hierarchy == nil {
+		w.opts.Hierarchy = DefaultHierarchy
+	}
+
+	if len(opts.Types) > 0 {
+		w.types = make(map[ObjectType]bool, len(opts.Types))
+		for _, t := range opts.Types {
+			w.types[t] = true
+		}
+	}
+
+	if opts.Concurrency > 1 {
+		w.sem = make(chan struct{}, opts.Concurrency-1)
+	}
+
+	w.visit(ctx, root, 0)
+	w.wg.Wait()
+
+	if errors.Is(w.err, ErrStopIteration) {
+		return nil
+	}
+
+	return w.err
+}
+
+// visit calls the WalkFunc for e, if e passes the type filter, and descends into its children.
+func (w *walker) visit(ctx context.Context, e APIEntity, depth int) {
+	if err := ctx.Err(); err != nil {
+		w.fail(err)
+		return
+	}
+
+	if w.types == nil || w.types[ObjectType(e.Type)] {
+		w.mu.Lock()
+		if err := ctx.Err(); err != nil {
+			w.mu.Unlock()
+			w.fail(err)
+			return
+		}
+		err := w.fn(e, depth)
+		w.mu.Unlock()
+
+		if errors.Is(err, SkipDir) {
+			return
+		}
+
+		if err != nil {
+			w.fail(err)
+			return
+		}
+	}
+
+	if w.opts.MaxDepth > 0 && depth >= w.opts.MaxDepth {
+		return
+	}
+
+	for _, t := range w.opts.Hierarchy[ObjectType(e.Type)] {
+		if err := w.children(ctx, e, t, depth+1); err != nil {
+			w.fail(err)
+			return
+		}
+	}
+}
+
+// children lists the children of e of type t and visits them. When the walk is concurrent, children that can have
+// children of their own are visited in a new goroutine if the semaphore has room for one, and in the calling
+// goroutine otherwise, so that the walk never runs more than Concurrency goroutines.
+func (w *walker) children(ctx context.Context, e APIEntity, t ObjectType, depth int) error {
+	opts := PageOptions{PageSize: w.opts.PageSize}
+	err := w.b.GetEntitiesEach(ctx, int(e.ID), t, opts, func(child APIEntity) error {
+		if w.sem == nil || len(w.opts.Hierarchy[t]) == 0 || (w.opts.MaxDepth > 0 && depth >= w.opts.MaxDepth) {
+			w.visit(ctx, child, depth)
+			return ctx.Err()
+		}
+
+		select {
+		case w.sem <- struct{}{}:
+			w.wg.Add(1)
+			go func() {
+				defer w.wg.Done()
+				defer func() { <-w.sem }()
+				w.visit(ctx, child, depth)
+			}()
+		default:
+			w.visit(ctx, child, depth)
+		}
+
+		return ctx.Err()
+	})
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// fail records the first error of the walk and cancels the remaining work. Once the walk has been canceled this way,
+// the context errors that the remaining work runs into are not recorded, so that the first error is kept.
+func (w *walker) fail(err error) {
+	w.once.Do(func() {
+		w.err = err
+		w.cancel()
+	})
+}
@@ -0,0 +1,282 @@
+package bluecat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// walkTree is the hierarchy served by newWalkSession, by parent ID and child type.
+var walkTree = map[int64]map[ObjectType][]APIEntity{
+	1: {ObjectTypeIP4Block: {{ID: 2, Name: "10.0.0.0/8", Type: "IP4Block"}, {ID: 3, Name: "172.16.0.0/12", Type: "IP4Block"}}},
+	2: {ObjectTypeIP4Network: {{ID: 4, Name: "net4", Type: "IP4Network"}, {ID: 5, Name: "net5", Type: "IP4Network"}}},
+	3: {ObjectTypeIP4Block: {{ID: 6, Name: "172.16.0.0/16", Type: "IP4Block"}}},
+	4: {ObjectTypeIP4Address: {{ID: 7, Name: "a7", Type: "IP4Address"}, {ID: 8, Name: "a8", Type: "IP4Address"}}},
+	6: {ObjectTypeIP4Network: {{ID: 9, Name: "net9", Type: "IP4Network"}}},
+	9: {ObjectTypeDHCP4Range: {{ID: 10, Name: "range10", Type: "DHCP4Range"}}},
+}
+
+// newWalkSession returns a session against a server that serves walkTree below the configuration 1. Every
+// getEntities call is delayed by `delay`.
+func newWalkSession(t *testing.T, delay time.Duration) (*Bluecat, *testServer) {
+	return newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if strings.HasSuffix(r.URL.Path, "/getEntityById") {
+			json.NewEncoder(w).Encode(APIEntity{ID: 1, Name: "config", Type: "Configuration"})
+			return
+		}
+
+		time.Sleep(delay)
+		parent, _ := strconv.ParseInt(q.Get("parentId"), 10, 64)
+		page := walkTree[parent][ObjectType(q.Get("type"))]
+		if start, _ := strconv.Atoi(q.Get("start")); start > 0 {
+			page = nil
+		}
+
+		if page == nil {
+			page = []APIEntity{}
+		}
+		json.NewEncoder(w).Encode(page)
+	})
+}
+
+func TestWalk(t *testing.T) {
+	errWalk := errors.New("walk failed")
+
+	tests := []struct {
+		name    string
+		opts    WalkOptions
+		fn      func(e APIEntity, depth int) error
+		want    []int64
+		wantErr error
+	}{
+		{name: "all", want: []int64{1, 2, 4, 7, 8, 5, 3, 6, 9, 10}},
+		{name: "types", opts: WalkOptions{Types: []ObjectType{ObjectTypeIP4Network}}, want: []int64{4, 5, 9}},
+		{name: "max depth", opts: WalkOptions{MaxDepth: 2}, want: []int64{1, 2, 4, 5, 3, 6}},
+		{
+			name: "skip dir",
+			fn: func(e APIEntity, depth int) error {
+				if e.ID == 2 {
+					return SkipDir
+				}
+				return nil
+			},
+			want: []int64{1, 2, 3, 6, 9, 10},
+		},
+		{
+			name: "wrapped skip dir",
+			fn: func(e APIEntity, depth int) error {
+				if e.ID == 2 {
+					return fmt.Errorf("block %d: %w", e.ID, SkipDir)
+				}
+				return nil
+			},
+			want: []int64{1, 2, 3, 6, 9, 10},
+		},
+		{
+			name: "stop iteration",
+			fn: func(e APIEntity, depth int) error {
+				if e.ID == 7 {
+					return ErrStopIteration
+				}
+				return nil
+			},
+			want: []int64{1, 2, 4, 7},
+		},
+		{
+			name: "error",
+			fn: func(e APIEntity, depth int) error {
+				if e.ID == 5 {
+					return errWalk
+				}
+				return nil
+			},
+			want:    []int64{1, 2, 4, 7, 8, 5},
+			wantErr: errWalk,
+		},
+		{
+			name: "hierarchy",
+			opts: WalkOptions{Hierarchy: map[ObjectType][]ObjectType{ObjectTypeConfiguration: {ObjectTypeIP4Block}}},
+			want: []int64{1, 2, 3},
+		},
+	}
+
+	b, ts := newWalkSession(t, 0)
+	defer ts.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int64
+			err := b.Walk(context.Background(), 1, tt.opts, func(e APIEntity, depth int) error {
+				got = append(got, e.ID)
+				if tt.fn != nil {
+					return tt.fn(e, depth)
+				}
+				return nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Walk error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Walk visited %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWalkDepth(t *testing.T) {
+	b, ts := newWalkSession(t, 0)
+	defer ts.Close()
+
+	want := map[int64]int{1: 0, 2: 1, 3: 1, 4: 2, 5: 2, 6: 2, 7: 3, 8: 3, 9: 3, 10: 4}
+	got := map[int64]int{}
+	err := b.Walk(context.Background(), 1, WalkOptions{}, func(e APIEntity, depth int) error {
+		got[e.ID] = depth
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Walk depths = %v, want %v", got, want)
+	}
+}
+
+func TestWalkConcurrent(t *testing.T) {
+	b, ts := newWalkSession(t, 5*time.Millisecond)
+	defer ts.Close()
+
+	var active, overlaps int32
+	var got []int64
+	err := b.Walk(context.Background(), 1, WalkOptions{Concurrency: 4}, func(e APIEntity, depth int) error {
+		if atomic.AddInt32(&active, 1) > 1 {
+			atomic.AddInt32(&overlaps, 1)
+		}
+		defer atomic.AddInt32(&active, -1)
+
+		got = append(got, e.ID)
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+
+	if overlaps > 0 {
+		t.Errorf("WalkFunc was called concurrently %d times", overlaps)
+	}
+
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+	if want := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Walk visited %v, want %v", got, want)
+	}
+}
+
+func TestWalkGoroutines(t *testing.T) {
+	const networks = 100
+	var inflight, maxInflight int32
+	b, ts := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if strings.HasSuffix(r.URL.Path, "/getEntityById") {
+			json.NewEncoder(w).Encode(APIEntity{ID: 1, Name: "10.0.0.0/8", Type: "IP4Block"})
+			return
+		}
+
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for m := atomic.LoadInt32(&maxInflight); n > m && !atomic.CompareAndSwapInt32(&maxInflight, m, n); {
+			m = atomic.LoadInt32(&maxInflight)
+		}
+		time.Sleep(2 * time.Millisecond)
+
+		page := []APIEntity{}
+		if q.Get("parentId") == "1" && q.Get("type") == string(ObjectTypeIP4Network) && q.Get("start") == "0" {
+			for i := 0; i < networks; i++ {
+				page = append(page, APIEntity{ID: int64(100 + i), Name: "net", Type: "IP4Network"})
+			}
+		}
+		json.NewEncoder(w).Encode(page)
+	})
+	defer ts.Close()
+
+	base := runtime.NumGoroutine()
+	var visited, maxGoroutines int
+	err := b.Walk(context.Background(), 1, WalkOptions{Concurrency: 2, PageSize: networks}, func(e APIEntity, depth int) error {
+		visited++
+		if n := runtime.NumGoroutine(); n > maxGoroutines {
+			maxGoroutines = n
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+
+	if visited != networks+1 {
+		t.Errorf("Walk visited %d entities, want %d", visited, networks+1)
+	}
+
+	if maxInflight > 2 {
+		t.Errorf("%d getEntities calls were in flight at once, want at most 2", maxInflight)
+	}
+
+	if extra := maxGoroutines - base; extra > networks/4 {
+		t.Errorf("Walk ran %d more goroutines than before it started, want it bounded by Concurrency", extra)
+	}
+}
+
+func TestWalkContext(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		t.Run("concurrency "+strconv.Itoa(concurrency), func(t *testing.T) {
+			b, ts := newWalkSession(t, 300*time.Millisecond)
+			defer ts.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			var visited int32
+			err := b.Walk(ctx, 1, WalkOptions{Concurrency: concurrency}, func(e APIEntity, depth int) error {
+				atomic.AddInt32(&visited, 1)
+				return nil
+			})
+
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("Walk error = %v, want context.DeadlineExceeded", err)
+			}
+
+			if visited != 1 {
+				t.Errorf("Walk visited %d entities, want only the root", visited)
+			}
+		})
+	}
+
+	t.Run("canceled", func(t *testing.T) {
+		b, ts := newWalkSession(t, 0)
+		defer ts.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		err := b.Walk(ctx, 1, WalkOptions{Concurrency: 2}, func(e APIEntity, depth int) error {
+			if e.ID == 4 {
+				cancel()
+			}
+			return nil
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Walk error = %v, want context.Canceled", err)
+		}
+	})
+}